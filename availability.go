@@ -0,0 +1,139 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"strings"
+)
+
+// State is the availability state of domain
+type State int
+
+const (
+	// StateInvalid whois data is invalid
+	StateInvalid State = iota
+	// StateRegistered domain is registered
+	StateRegistered
+	// StateAvailable domain is available to register
+	StateAvailable
+	// StateReserved domain is reserved to register
+	StateReserved
+	// StatePremium domain is available at premium price
+	StatePremium
+	// StateBlocked domain is blocked due to brand protection
+	StateBlocked
+	// StatePendingDelete domain is pending delete
+	StatePendingDelete
+	// StateRedemption domain is in redemption period
+	StateRedemption
+	// StateQuarantine domain is in quarantine
+	StateQuarantine
+	// StateWithheld domain is withheld from registration
+	StateWithheld
+	// StateUnavailable domain is not available for registration by policy
+	StateUnavailable
+	// StateRateLimited whois query is limited
+	StateRateLimited
+)
+
+var (
+	// stateNames is the names of states
+	stateNames = map[State]string{
+		StateInvalid:       "invalid",
+		StateRegistered:    "registered",
+		StateAvailable:     "available",
+		StateReserved:      "reserved",
+		StatePremium:       "premium",
+		StateBlocked:       "blocked",
+		StatePendingDelete: "pending_delete",
+		StateRedemption:    "redemption",
+		StateQuarantine:    "quarantine",
+		StateWithheld:      "withheld",
+		StateUnavailable:   "unavailable",
+		StateRateLimited:   "rate_limited",
+	}
+
+	// errorStates is the states of domain errors
+	errorStates = map[error]State{
+		ErrNotFoundDomain:      StateAvailable,
+		ErrReservedDomain:      StateReserved,
+		ErrPremiumDomain:       StatePremium,
+		ErrBlockedDomain:       StateBlocked,
+		ErrPendingDeleteDomain: StatePendingDelete,
+		ErrRedemptionDomain:    StateRedemption,
+		ErrQuarantineDomain:    StateQuarantine,
+		ErrWithheldDomain:      StateWithheld,
+		ErrUnavailableDomain:   StateUnavailable,
+		ErrDomainLimitExceed:   StateRateLimited,
+	}
+)
+
+// String returns the name of state
+func (s State) String() string {
+	if name, ok := stateNames[s]; ok {
+		return name
+	}
+
+	return stateNames[StateInvalid]
+}
+
+// Availability storing the availability state of domain and its evidence
+type Availability struct {
+	State    State  `json:"state"`
+	Evidence string `json:"evidence,omitempty"`
+}
+
+// Classify returns the availability of domain from whois data without parsing it,
+// the evidence is the text of whois data the state is decided by, e.g. the line
+// "No match for "EXAMPLE.COM"." for StateAvailable. The domain status lines of a
+// registered domain are checked for StatePendingDelete and StateRedemption.
+func Classify(text string) Availability {
+	name, extension := searchDomain(text)
+
+	// domain name without extension may be a word of the message
+	if name == "" || extension == "" {
+		if rule, evidence := searchDomainError(text); rule.err != nil {
+			return Availability{State: errorStates[rule.err], Evidence: evidence}
+		}
+	}
+
+	if name == "" {
+		return Availability{State: StateInvalid}
+	}
+
+	if extension != "" {
+		if rule := searchExtDomainError(text, extension); rule.err != nil {
+			return Availability{State: errorStates[rule.err], Evidence: rule.key}
+		}
+	}
+
+	if rule, evidence := searchStatusDomainError(text); rule.err != nil {
+		return Availability{State: errorStates[rule.err], Evidence: evidence}
+	}
+
+	evidence := ""
+	if m := searchDomainRx1.FindString(text); m != "" {
+		evidence = strings.TrimSpace(m)
+	} else {
+		evidence = strings.TrimSpace(searchDomainRx2.FindString(text))
+	}
+
+	return Availability{State: StateRegistered, Evidence: evidence}
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/likexian/gokit/assert"
+	"github.com/likexian/gokit/xfile"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		data string
+		exp  Availability
+	}{
+		{
+			"Domain Name: GOOGLE.COM\nRegistry Domain ID: 2138514_DOMAIN_COM-VRSN",
+			Availability{StateRegistered, "Domain Name: GOOGLE.COM"},
+		},
+		{
+			"No match for \"LIKEXIAN-NO-MONEY.COM\".\n>>> Last update of whois database: 2021-01-15T11:26:46Z <<<",
+			Availability{StateAvailable, "No match for \"LIKEXIAN-NO-MONEY.COM\"."},
+		},
+		{
+			"Domain: likexian-no-money.de\nStatus: free",
+			Availability{StateAvailable, "Status: free"},
+		},
+		{
+			"Reserved Domain Name\nURL of the ICANN Whois Inaccuracy Complaint Form: https://www.icann.org/wicf/",
+			Availability{StateReserved, "Reserved Domain Name"},
+		},
+		{
+			"This platinum domain is available for purchase.",
+			Availability{StatePremium, "This platinum domain is available for purchase."},
+		},
+		{
+			"This name subscribes to the Uni EPS+ product",
+			Availability{StateBlocked, "This name subscribes to the Uni EPS+ product"},
+		},
+		{
+			"Domain: example.eu\nScript: LATIN\n\nStatus: IN QUARANTINE",
+			Availability{StateQuarantine, "Status: IN QUARANTINE"},
+		},
+		{
+			"This name is pending delete.",
+			Availability{StatePendingDelete, "This name is pending delete."},
+		},
+		{
+			"WHOIS LIMIT EXCEEDED - SEE WWW.PIR.ORG/WHOIS FOR DETAILS",
+			Availability{StateRateLimited, "WHOIS LIMIT EXCEEDED - SEE WWW.PIR.ORG/WHOIS FOR DETAILS"},
+		},
+		{
+			"connect to whois server failed: dial tcp 43: i/o timeout",
+			Availability{StateInvalid, ""},
+		},
+	}
+
+	for _, v := range tests {
+		assert.Equal(t, Classify(v.data), v.exp, v.data)
+	}
+}
+
+func TestClassifyCorpus(t *testing.T) {
+	tests := map[string]State{
+		noterrorDir: StateRegistered,
+		notfoundDir: StateAvailable,
+	}
+
+	for dir, state := range tests {
+		for _, v := range listCorpus(t, dir) {
+			whoisRaw, err := xfile.ReadText(dir + "/" + v.name)
+			assert.Nil(t, err)
+			availability := Classify(whoisRaw)
+			assert.Equal(t, availability.State, state, v.name)
+			assert.NotEqual(t, availability.Evidence, "", v.name)
+		}
+	}
+}
+
+func TestClassifyStatus(t *testing.T) {
+	whoisRaw, err := xfile.ReadText(noterrorDir + "/com_google.com")
+	assert.Nil(t, err)
+
+	tests := map[string]State{
+		"Domain Status: pendingDelete https://icann.org/epp#pendingDelete":       StatePendingDelete,
+		"Domain Status: redemptionPeriod https://icann.org/epp#redemptionPeriod": StateRedemption,
+	}
+
+	for status, state := range tests {
+		data := strings.Replace(whoisRaw,
+			"Domain Status: clientUpdateProhibited (https://www.icann.org/epp#clientUpdateProhibited)", status, 1)
+		assert.Equal(t, Classify(data), Availability{state, status}, status)
+	}
+}
+
+func TestStateString(t *testing.T) {
+	assert.Equal(t, StateRegistered.String(), "registered")
+	assert.Equal(t, StateRateLimited.String(), "rate_limited")
+	assert.Equal(t, State(100).String(), "invalid")
+}
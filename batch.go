@@ -0,0 +1,197 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// Input is a whois raw text to parse in batch
+type Input struct {
+	// ID is set by caller to identify the result
+	ID   string
+	Text string
+}
+
+// Result is the parsed result of a batch input
+type Result struct {
+	// Index is the position of input in the batch, starting from 0
+	Index     int
+	ID        string
+	WhoisInfo WhoisInfo
+	Err       error
+}
+
+// BatchOption is the option of batch parsing
+type BatchOption func(*batchOptions)
+
+// batchOptions storing the options of batch parsing
+type batchOptions struct {
+	concurrency int
+	ordered     bool
+}
+
+// batchJob is an input with its position in the batch
+type batchJob struct {
+	index int
+	input Input
+}
+
+// WithConcurrency sets the max number of inputs parsed at the same time,
+// the default is runtime.GOMAXPROCS(0)
+func WithConcurrency(concurrency int) BatchOption {
+	return func(o *batchOptions) {
+		o.concurrency = concurrency
+	}
+}
+
+// WithOrdered sets if the results are sent in the order of inputs,
+// the default is false, results are sent as soon as they are parsed
+func WithOrdered(ordered bool) BatchOption {
+	return func(o *batchOptions) {
+		o.ordered = ordered
+	}
+}
+
+// ParseBatch parses the inputs concurrently and sends the results to the returned channel,
+// the channel is closed after inputs is closed and all of them are parsed, or ctx is done,
+// in which case the inputs not parsed yet are dropped. Errors are returned per result.
+// The results of date strings are cached within the batch.
+func ParseBatch(ctx context.Context, inputs <-chan Input, options ...BatchOption) <-chan Result {
+	opts := batchOptions{
+		concurrency: runtime.GOMAXPROCS(0),
+	}
+
+	for _, o := range options {
+		o(&opts)
+	}
+
+	if opts.concurrency < 1 {
+		opts.concurrency = 1
+	}
+
+	// tokens bounds the results waiting to be sent in order
+	var tokens chan struct{}
+	if opts.ordered {
+		tokens = make(chan struct{}, opts.concurrency)
+	}
+
+	jobs := make(chan batchJob)
+	go dispatchBatch(ctx, inputs, jobs, tokens)
+
+	// dates is shared by the workers, so the same date strings are parsed once
+	dates := newDateCache()
+
+	results := make(chan Result)
+	wg := sync.WaitGroup{}
+	for i := 0; i < opts.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				result := Result{
+					Index: job.index,
+					ID:    job.input.ID,
+				}
+				result.WhoisInfo, result.Err = parse(job.input.Text, dates)
+				select {
+				case results <- result:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	if !opts.ordered {
+		return results
+	}
+
+	ordered := make(chan Result)
+	go orderBatch(ctx, results, ordered, tokens)
+
+	return ordered
+}
+
+// dispatchBatch reads inputs and sends them as jobs with their position,
+// a token is acquired for each job if tokens is not nil
+func dispatchBatch(ctx context.Context, inputs <-chan Input, jobs chan<- batchJob, tokens chan struct{}) {
+	defer close(jobs)
+
+	for index := 0; ; index++ {
+		var input Input
+		select {
+		case v, ok := <-inputs:
+			if !ok {
+				return
+			}
+			input = v
+		case <-ctx.Done():
+			return
+		}
+
+		if tokens != nil {
+			select {
+			case tokens <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case jobs <- batchJob{index, input}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// orderBatch sends the results in the order of inputs,
+// the token of a job is released after its result is sent
+func orderBatch(ctx context.Context, results <-chan Result, ordered chan<- Result, tokens chan struct{}) {
+	defer close(ordered)
+
+	next := 0
+	pending := map[int]Result{}
+	for result := range results {
+		pending[result.Index] = result
+		for {
+			v, ok := pending[next]
+			if !ok {
+				break
+			}
+			select {
+			case ordered <- v:
+			case <-ctx.Done():
+				return
+			}
+			delete(pending, next)
+			<-tokens
+			next++
+		}
+	}
+}
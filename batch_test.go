@@ -0,0 +1,99 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/likexian/gokit/assert"
+)
+
+// batchInputs returns the batch inputs of corpus in dir
+func batchInputs(t *testing.T, dir string) []Input {
+	inputs := []Input{}
+	for k, v := range readCorpus(t, dir) {
+		inputs = append(inputs, Input{ID: k.name, Text: v})
+	}
+
+	return inputs
+}
+
+// sendInputs sends inputs to a channel and closes it, it stops if ctx is done
+func sendInputs(ctx context.Context, inputs []Input) <-chan Input {
+	result := make(chan Input)
+	go func() {
+		defer close(result)
+		for _, v := range inputs {
+			select {
+			case result <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return result
+}
+
+func TestParseBatch(t *testing.T) {
+	inputs := append(batchInputs(t, noterrorDir), batchInputs(t, notfoundDir)...)
+
+	for _, ordered := range []bool{true, false} {
+		ctx := context.Background()
+		results := ParseBatch(ctx, sendInputs(ctx, inputs), WithConcurrency(8), WithOrdered(ordered))
+
+		index := 0
+		seen := map[int]bool{}
+		for result := range results {
+			if ordered {
+				assert.Equal(t, result.Index, index)
+			}
+			seen[result.Index] = true
+			index++
+
+			whoisInfo, err := Parse(inputs[result.Index].Text)
+			assert.Equal(t, result.ID, inputs[result.Index].ID)
+			assert.Equal(t, result.Err, err, result.ID)
+			assert.Equal(t, result.WhoisInfo, whoisInfo, result.ID)
+		}
+
+		assert.Equal(t, len(seen), len(inputs))
+	}
+}
+
+func TestParseBatchCancel(t *testing.T) {
+	inputs := batchInputs(t, noterrorDir)
+
+	for _, ordered := range []bool{true, false} {
+		ctx, cancel := context.WithCancel(context.Background())
+		results := ParseBatch(ctx, sendInputs(ctx, inputs), WithConcurrency(0), WithOrdered(ordered))
+
+		<-results
+		cancel()
+
+		count := 1
+		for range results {
+			count++
+		}
+
+		assert.True(t, count < len(inputs))
+	}
+}
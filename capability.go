@@ -0,0 +1,225 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// Field is the path of a whois info field, e.g. "domain.created_date"
+type Field string
+
+const (
+	// FieldDomainID is Domain.ID
+	FieldDomainID Field = "domain.id"
+	// FieldDomainStatus is Domain.Status
+	FieldDomainStatus Field = "domain.status"
+	// FieldDomainWhoisServer is Domain.WhoisServer
+	FieldDomainWhoisServer Field = "domain.whois_server"
+	// FieldDomainNameServers is Domain.NameServers
+	FieldDomainNameServers Field = "domain.name_servers"
+	// FieldDomainCreatedDate is Domain.CreatedDate
+	FieldDomainCreatedDate Field = "domain.created_date"
+	// FieldDomainUpdatedDate is Domain.UpdatedDate
+	FieldDomainUpdatedDate Field = "domain.updated_date"
+	// FieldDomainExpirationDate is Domain.ExpirationDate
+	FieldDomainExpirationDate Field = "domain.expiration_date"
+	// FieldRegistrarID is Registrar.ID
+	FieldRegistrarID Field = "registrar.id"
+	// FieldRegistrarName is Registrar.Name
+	FieldRegistrarName Field = "registrar.name"
+	// FieldRegistrarReferralURL is Registrar.ReferralURL
+	FieldRegistrarReferralURL Field = "registrar.referral_url"
+)
+
+var (
+	// capabilityFields is the fields described by capability manifest
+	capabilityFields = []Field{
+		FieldDomainID,
+		FieldDomainStatus,
+		FieldDomainWhoisServer,
+		FieldDomainNameServers,
+		FieldDomainCreatedDate,
+		FieldDomainUpdatedDate,
+		FieldDomainExpirationDate,
+		FieldRegistrarID,
+		FieldRegistrarName,
+		FieldRegistrarReferralURL,
+	}
+
+	// unpublishedFields is the extensions that never publish the field,
+	// the empty extension is for the tld whois info from IANA
+	unpublishedFields = map[Field][]string{
+		FieldDomainID: {
+			"", "at", "aq", "br", "ch", "de", "edu", "eu", "fr", "gg", "gov", "hk",
+			"hm", "int", "it", "jp", "kr", "kz", "mo", "nl", "nz", "pl", "pm", "re", "ro", "ru", "su", "tf", "ee",
+			"tk", "travel", "tv", "tw", "uk", "wf", "yt", "ir", "fi", "rs", "dk", "by", "ua",
+			"xn--j1amh", "xn--mgba3a4f16a", "xn--p1ai", "se", "sk", "nu", "hu",
+		},
+		FieldDomainStatus: {
+			"at", "ch", "edu", "eu", "int", "kr", "mo", "tw", "ir", "pl", "tk", "by",
+			"xn--mgba3a4f16a", "hu",
+		},
+		FieldDomainWhoisServer: {
+			"aero", "ai", "at", "aq", "asia", "berlin", "biz", "br", "ch", "cn",
+			"co", "cymru", "de", "edu", "eu", "fr", "gg", "gov", "hk", "hm", "in", "int", "it", "jp", "kr",
+			"la", "london", "me", "mo", "museum", "name", "nl", "nz", "pm", "re", "ro", "ru", "sh", "sk",
+			"kz", "su", "tel", "ee", "tf", "tk", "travel", "tw", "uk", "us", "wales", "wf", "xxx",
+			"yt", "ir", "fi", "rs", "dk", "by", "ua", "xn--mgba3a4f16a", "xn--fiqs8s", "xn--p1ai",
+			"se", "nu", "hu", "xn--j1amh",
+		},
+		FieldDomainNameServers: {
+			"gov", "name", "tw", "hu",
+		},
+		FieldDomainCreatedDate: {
+			"aq", "ai", "at", "au", "de", "eu", "gov", "hm", "name", "nl", "nz", "ir", "tk",
+			"xn--mgba3a4f16a", "ac.jp", "co.jp", "go.jp", "ne.jp",
+		},
+		FieldDomainUpdatedDate: {
+			"aq", "ai", "at", "ch", "cn", "eu", "gg", "gov", "hk", "hm", "mo",
+			"name", "nl", "ro", "ru", "su", "tk", "tw", "dk", "xn--fiqs8s", "xn--p1ai", "hu",
+		},
+		FieldDomainExpirationDate: {
+			"", "ai", "at", "aq", "au", "br", "ch", "de", "eu", "gg", "gov", "ee",
+			"hm", "int", "name", "nl", "nz", "tk", "kz", "hu", "ac.jp", "co.jp", "go.jp", "ne.jp",
+		},
+		FieldRegistrarID: {
+			"", "ai", "at", "aq", "au", "br", "ca", "ch", "cn", "cx", "de",
+			"edu", "eu", "fr", "gg", "gov", "gs", "hk", "hm", "int", "it", "jp", "kr", "kz", "la", "mo", "nl",
+			"nz", "pl", "pm", "re", "ro", "ru", "su", "sk", "tf", "tk", "tw", "uk", "wf", "yt", "ir", "fi", "rs",
+			"ee", "dk", "by", "ua", "xn--mgba3a4f16a", "xn--fiqs8s", "xn--p1ai", "se", "nu", "hu",
+			"xn--j1amh",
+		},
+		FieldRegistrarName: {
+			"", "at", "aq", "br", "de",
+			"edu", "gov", "hm", "int", "jp", "mo", "tk", "ir", "dk", "xn--mgba3a4f16a", "hu",
+		},
+		FieldRegistrarReferralURL: {
+			"", "aero", "ai", "at", "aq", "asia", "au", "br", "ch", "cn", "de",
+			"edu", "gov", "hk", "hm", "int", "jp", "kr", "kz", "la", "london", "love", "mo",
+			"museum", "name", "nl", "nz", "pl", "ru", "sk", "su", "tk", "top", "ir", "fi", "rs", "dk", "by", "ua",
+			"xn--mgba3a4f16a", "xn--fiqs8s", "xn--p1ai", "se", "nu", "hu", "xn--j1amh",
+		},
+	}
+)
+
+// Capability storing the whois info fields a registry is expected to populate
+type Capability struct {
+	Extension string  `json:"extension"`
+	Fields    []Field `json:"fields"`
+}
+
+// Capabilities returns the capability manifest of domain extension,
+// ext may be an extension like "com" or "co.jp", or a full domain name,
+// in which case every suffix of it is matched. The empty ext is for the
+// tld whois info from IANA.
+func Capabilities(ext string) Capability {
+	ext = strings.Trim(strings.ToLower(strings.TrimSpace(ext)), ".")
+	if ascii, err := idna.ToASCII(ext); err == nil {
+		ext = ascii
+	}
+
+	suffixes := []string{ext}
+	for s := ext; strings.Contains(s, "."); {
+		s = s[strings.Index(s, ".")+1:]
+		suffixes = append(suffixes, s)
+	}
+
+	capability := Capability{
+		Extension: ext,
+		Fields:    []Field{},
+	}
+
+	for _, field := range capabilityFields {
+		published := true
+		for _, v := range suffixes {
+			if slices.Contains(unpublishedFields[field], v) {
+				published = false
+				break
+			}
+		}
+		if published {
+			capability.Fields = append(capability.Fields, field)
+		}
+	}
+
+	return capability
+}
+
+// Expects returns if the field is expected to be populated
+func (c Capability) Expects(field Field) bool {
+	return slices.Contains(c.Fields, field)
+}
+
+// Missing returns the expected fields that are not populated in whois info,
+// a field not expected by the capability is never reported as missing,
+// because the registry does not publish it.
+func (c Capability) Missing(whoisInfo WhoisInfo) []Field {
+	missing := []Field{}
+
+	for _, field := range c.Fields {
+		if !whoisInfo.hasField(field) {
+			missing = append(missing, field)
+		}
+	}
+
+	return missing
+}
+
+// hasField returns if the field of whois info is populated
+func (w WhoisInfo) hasField(field Field) bool {
+	domain := w.Domain
+	if domain == nil {
+		domain = &Domain{}
+	}
+
+	registrar := w.Registrar
+	if registrar == nil {
+		registrar = &Contact{}
+	}
+
+	switch field {
+	case FieldDomainID:
+		return domain.ID != ""
+	case FieldDomainStatus:
+		return len(domain.Status) > 0
+	case FieldDomainWhoisServer:
+		return domain.WhoisServer != ""
+	case FieldDomainNameServers:
+		return len(domain.NameServers) > 0
+	case FieldDomainCreatedDate:
+		return domain.CreatedDate != ""
+	case FieldDomainUpdatedDate:
+		return domain.UpdatedDate != ""
+	case FieldDomainExpirationDate:
+		return domain.ExpirationDate != ""
+	case FieldRegistrarID:
+		return registrar.ID != ""
+	case FieldRegistrarName:
+		return registrar.Name != ""
+	case FieldRegistrarReferralURL:
+		return registrar.ReferralURL != ""
+	default:
+		return false
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"testing"
+
+	"github.com/likexian/gokit/assert"
+)
+
+func TestCapabilities(t *testing.T) {
+	capability := Capabilities("com")
+	assert.Equal(t, capability.Extension, "com")
+	assert.Equal(t, capability.Fields, capabilityFields)
+
+	capability = Capabilities("jp")
+	assert.False(t, capability.Expects(FieldDomainID))
+	assert.True(t, capability.Expects(FieldDomainCreatedDate))
+	assert.True(t, capability.Expects(FieldDomainExpirationDate))
+
+	for _, v := range []string{"co.jp", ".CO.JP", "google.co.jp"} {
+		capability = Capabilities(v)
+		assert.False(t, capability.Expects(FieldDomainID), v)
+		assert.False(t, capability.Expects(FieldDomainCreatedDate), v)
+		assert.False(t, capability.Expects(FieldDomainExpirationDate), v)
+		assert.True(t, capability.Expects(FieldDomainUpdatedDate), v)
+	}
+
+	capability = Capabilities("рф")
+	assert.Equal(t, capability.Extension, "xn--p1ai")
+	assert.False(t, capability.Expects(FieldDomainID))
+
+	capability = Capabilities("")
+	assert.False(t, capability.Expects(FieldRegistrarName))
+	assert.True(t, capability.Expects(FieldDomainNameServers))
+}
+
+func TestCapabilityMissing(t *testing.T) {
+	capability := Capabilities("com")
+	assert.Equal(t, capability.Missing(WhoisInfo{}), capabilityFields)
+
+	whoisInfo := WhoisInfo{
+		Domain: &Domain{
+			ID:             "2138514_DOMAIN_COM-VRSN",
+			Status:         []string{"clientUpdateProhibited"},
+			WhoisServer:    "whois.markmonitor.com",
+			NameServers:    []string{"ns1.google.com"},
+			CreatedDate:    "1997-09-15T04:00:00Z",
+			ExpirationDate: "2028-09-14T04:00:00Z",
+		},
+		Registrar: &Contact{
+			Name: "MarkMonitor Inc.",
+		},
+	}
+
+	assert.Equal(t, capability.Missing(whoisInfo), []Field{
+		FieldDomainUpdatedDate,
+		FieldRegistrarID,
+		FieldRegistrarReferralURL,
+	})
+
+	capability = Capabilities("de")
+	assert.Equal(t, capability.Missing(whoisInfo), []Field{
+		FieldDomainUpdatedDate,
+	})
+}
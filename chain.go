@@ -0,0 +1,280 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// ChainInfo storing merged whois info of a referral chain
+type ChainInfo struct {
+	WhoisInfo
+	Hops    []ChainHop        `json:"hops,omitempty"`
+	Sources map[string]string `json:"sources,omitempty"`
+}
+
+// ChainHop storing whois info of a single referral hop
+type ChainHop struct {
+	Server    string    `json:"server,omitempty"`
+	WhoisInfo WhoisInfo `json:"whois_info"`
+	Err       error     `json:"-"`
+}
+
+// chainPart is a single response split from the concatenated output
+type chainPart struct {
+	server string
+	text   string
+}
+
+var (
+	chainHeaderRx = regexp.MustCompile(`(?m)^[ \t]*(?:\[(?:Querying[ \t]+)?([a-zA-Z0-9\.\-]+\.[a-zA-Z]{2,})\]` +
+		`|#[ \t]+([a-zA-Z0-9\.\-]+\.[a-zA-Z]{2,}))[ \t]*$`)
+	chainRedirectRx = regexp.MustCompile(`(?m)^[ \t]*\[Redirected to [^\]]+\][ \t]*$`)
+	chainFooterRx   = regexp.MustCompile(`(?im)^[ \t]*>>>[^\n]*<<<[ \t]*$`)
+	chainDomainRx   = regexp.MustCompile(`(?im)^[ \t]*domain[ \t]+name:[ \t]*\S+`)
+)
+
+// SplitChain splits the concatenated output of a referral chain into responses
+func SplitChain(text string) []string {
+	result := []string{}
+	for _, v := range splitChain(text) {
+		result = append(result, v.text)
+	}
+
+	return result
+}
+
+// splitChain splits the concatenated output by the server headers
+// and the ICANN footers, returns the responses with their server
+func splitChain(text string) []chainPart {
+	text = chainRedirectRx.ReplaceAllString(text, "")
+
+	parts := []chainPart{}
+	headers := chainHeaderRx.FindAllStringSubmatchIndex(text, -1)
+	if len(headers) == 0 {
+		return splitChainFooter("", text)
+	}
+
+	if head := strings.TrimSpace(text[:headers[0][0]]); head != "" {
+		parts = append(parts, splitChainFooter("", head)...)
+	}
+
+	for k, v := range headers {
+		end := len(text)
+		if k < len(headers)-1 {
+			end = headers[k+1][0]
+		}
+		server := ""
+		if v[2] != -1 {
+			server = text[v[2]:v[3]]
+		} else {
+			server = text[v[4]:v[5]]
+		}
+		parts = append(parts, splitChainFooter(strings.ToLower(server), text[v[1]:end])...)
+	}
+
+	return parts
+}
+
+// splitChainFooter splits the response after an ICANN footer
+// if there is another domain record following it
+func splitChainFooter(server, text string) []chainPart {
+	parts := []chainPart{}
+
+	for {
+		footer := chainFooterRx.FindStringIndex(text)
+		if footer == nil {
+			break
+		}
+		next := chainDomainRx.FindStringIndex(text[footer[1]:])
+		if next == nil {
+			break
+		}
+		parts = append(parts, chainPart{server, strings.TrimSpace(text[:footer[1]+next[0]])})
+		text = text[footer[1]+next[0]:]
+		server = ""
+	}
+
+	if text = strings.TrimSpace(text); text != "" {
+		parts = append(parts, chainPart{server, text})
+	}
+
+	return parts
+}
+
+// ParseChain parses the responses of a referral chain separately and merges them,
+// responses are ordered from the registry to the registrar, and a concatenated
+// response is split by SplitChain. Domain and registrar fields prefer the registry,
+// while the registrant, administrative, technical and billing contacts prefer
+// the registrar, the server of each field is recorded in Sources. The server of
+// a response without server header may be inferred from the referral of previous
+// response, the fields are not recorded if it is unknown. The whois info returned
+// with ErrDomainDataTruncated or a domain status error is merged, the error is kept on the hop.
+func ParseChain(responses ...string) (chainInfo ChainInfo, err error) {
+	parts := []chainPart{}
+	for _, v := range responses {
+		parts = append(parts, splitChain(v)...)
+	}
+
+	if len(parts) == 0 {
+		err = ErrDomainDataInvalid
+		return
+	}
+
+	referral := ""
+	infos := []WhoisInfo{}
+	servers := []string{}
+	for _, v := range parts {
+		hop := ChainHop{
+			Server: v.server,
+		}
+		if hop.Server == "" {
+			hop.Server = referral
+		}
+		hop.WhoisInfo, hop.Err = Parse(v.text)
+		var rateLimitErr *RateLimitError
+		if errors.As(hop.Err, &rateLimitErr) && rateLimitErr.Server == "" {
+			rateLimitErr.Server = hop.Server
+		}
+		chainInfo.Hops = append(chainInfo.Hops, hop)
+		if !isMergeableHop(hop) {
+			continue
+		}
+		if server := normalizeServer(hop.WhoisInfo.Domain.WhoisServer); server != "" {
+			referral = server
+		}
+		infos = append(infos, hop.WhoisInfo)
+		servers = append(servers, hop.Server)
+	}
+
+	if len(infos) == 0 {
+		err = chainInfo.Hops[0].Err
+		return
+	}
+
+	chainInfo.Sources = map[string]string{}
+	chainInfo.WhoisInfo = mergeChain(infos, servers, chainInfo.Sources)
+
+	return
+}
+
+// isMergeableHop returns if the whois info of hop can be merged, the partial whois info
+// returned with ErrDomainDataTruncated or a domain status error is merged too
+func isMergeableHop(hop ChainHop) bool {
+	if hop.Err == nil {
+		return true
+	}
+
+	if hop.WhoisInfo.Domain == nil {
+		return false
+	}
+
+	for _, v := range []error{ErrDomainDataTruncated, ErrPendingDeleteDomain, ErrRedemptionDomain} {
+		if errors.Is(hop.Err, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mergeChain merges the whois info of hops by the precedence rules
+func mergeChain(infos []WhoisInfo, servers []string, sources map[string]string) WhoisInfo {
+	registryFirst := make([]int, len(infos))
+	registrarFirst := make([]int, len(infos))
+	for k := range infos {
+		registryFirst[k] = k
+		registrarFirst[k] = len(infos) - 1 - k
+	}
+
+	whoisInfo := WhoisInfo{
+		Domain: &Domain{},
+	}
+
+	domains := []reflect.Value{}
+	domainServers := []string{}
+	for _, k := range registryFirst {
+		if infos[k].Domain != nil {
+			domains = append(domains, reflect.ValueOf(infos[k].Domain).Elem())
+			domainServers = append(domainServers, servers[k])
+		}
+	}
+	mergeStruct(reflect.ValueOf(whoisInfo.Domain).Elem(), domains, domainServers, "domain", sources)
+
+	contacts := []struct {
+		name  string
+		order []int
+		field func(*WhoisInfo) **Contact
+	}{
+		{"registrar", registryFirst, func(w *WhoisInfo) **Contact { return &w.Registrar }},
+		{"registrant", registrarFirst, func(w *WhoisInfo) **Contact { return &w.Registrant }},
+		{"administrative", registrarFirst, func(w *WhoisInfo) **Contact { return &w.Administrative }},
+		{"technical", registrarFirst, func(w *WhoisInfo) **Contact { return &w.Technical }},
+		{"billing", registrarFirst, func(w *WhoisInfo) **Contact { return &w.Billing }},
+	}
+
+	for _, c := range contacts {
+		values := []reflect.Value{}
+		valueServers := []string{}
+		for _, k := range c.order {
+			if contact := *c.field(&infos[k]); contact != nil {
+				values = append(values, reflect.ValueOf(contact).Elem())
+				valueServers = append(valueServers, servers[k])
+			}
+		}
+		contact := &Contact{}
+		mergeStruct(reflect.ValueOf(contact).Elem(), values, valueServers, c.name, sources)
+		if *contact != (Contact{}) {
+			*c.field(&whoisInfo) = contact
+		}
+	}
+
+	return whoisInfo
+}
+
+// mergeStruct sets each field of dst to the first non-zero field of srcs,
+// the parsed time fields always come from the same source as their string,
+// the server of field is recorded in sources if it is known.
+func mergeStruct(dst reflect.Value, srcs []reflect.Value, servers []string, prefix string, sources map[string]string) {
+	t := dst.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if strings.HasSuffix(field.Name, "InTime") {
+			continue
+		}
+		for k, src := range srcs {
+			if src.Field(i).IsZero() {
+				continue
+			}
+			dst.Field(i).Set(src.Field(i))
+			if _, ok := t.FieldByName(field.Name + "InTime"); ok {
+				dst.FieldByName(field.Name + "InTime").Set(src.FieldByName(field.Name + "InTime"))
+			}
+			if servers[k] != "" {
+				name := strings.Split(field.Tag.Get("json"), ",")[0]
+				sources[prefix+"."+name] = servers[k]
+			}
+			break
+		}
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/likexian/gokit/assert"
+	"github.com/likexian/gokit/xfile"
+)
+
+func TestSplitChain(t *testing.T) {
+	whoisRaw, err := xfile.ReadText(noterrorDir + "/com_rockcreekcc.com")
+	assert.Nil(t, err)
+
+	responses := SplitChain(whoisRaw)
+	assert.Equal(t, len(responses), 2)
+	assert.True(t, strings.HasPrefix(responses[0], "Domain Name: ROCKCREEKCC.COM"))
+	assert.True(t, strings.HasPrefix(responses[1], "Domain Name: ROCKCREEKCC.COM"))
+	assert.Contains(t, responses[0], "The Registry database contains ONLY")
+	assert.NotContains(t, responses[1], "The Registry database contains ONLY")
+
+	responses = SplitChain(`[Querying whois.verisign-grs.com]
+[Redirected to whois.markmonitor.com]
+[whois.verisign-grs.com]
+Domain Name: GOOGLE.COM
+
+[Querying whois.markmonitor.com]
+[whois.markmonitor.com]
+Domain Name: google.com`)
+	assert.Equal(t, responses, []string{"Domain Name: GOOGLE.COM", "Domain Name: google.com"})
+
+	responses = SplitChain("Domain Name: google.com")
+	assert.Equal(t, responses, []string{"Domain Name: google.com"})
+}
+
+func TestParseChain(t *testing.T) {
+	whoisRaw, err := xfile.ReadText(noterrorDir + "/com_rockcreekcc.com")
+	assert.Nil(t, err)
+
+	chainInfo, err := ParseChain(whoisRaw)
+	assert.Nil(t, err)
+	assert.Equal(t, len(chainInfo.Hops), 2)
+	assert.Equal(t, chainInfo.Hops[0].Server, "")
+	assert.Equal(t, chainInfo.Hops[1].Server, "whois.tucows.com")
+
+	assert.Equal(t, chainInfo.Domain.Domain, "rockcreekcc.com")
+	assert.Equal(t, chainInfo.Domain.ExpirationDate, "2022-07-12T15:48:26Z")
+	assert.NotNil(t, chainInfo.Domain.ExpirationDateInTime)
+	assert.Equal(t, chainInfo.Domain.NameServers, []string{"ns1.sterlink.net", "ns2.sterlink.net"})
+	_, ok := chainInfo.Sources["domain.expiration_date"]
+	assert.False(t, ok)
+
+	assert.Equal(t, chainInfo.Registrar.Name, "Tucows Domains Inc.")
+	assert.Equal(t, chainInfo.Registrar.Email, "domainabuse@tucows.com")
+	_, ok = chainInfo.Sources["registrar.name"]
+	assert.False(t, ok)
+	assert.Equal(t, chainInfo.Sources["registrar.email"], "whois.tucows.com")
+
+	assert.Equal(t, chainInfo.Registrant.Province, "OR")
+	assert.Equal(t, chainInfo.Sources["registrant.province"], "whois.tucows.com")
+	assert.NotNil(t, chainInfo.Technical)
+	assert.True(t, chainInfo.Billing == nil)
+
+	same, err := ParseChain(SplitChain(whoisRaw)...)
+	assert.Nil(t, err)
+	assert.Equal(t, same.WhoisInfo, chainInfo.WhoisInfo)
+	assert.Equal(t, same.Sources, chainInfo.Sources)
+
+	truncated := strings.Replace(whoisRaw, ">>> Last update of WHOIS database: 2021-06-01T15:55:46Z <<<", "", 1)
+	chainInfo, err = ParseChain(truncated)
+	assert.Nil(t, err)
+	assert.Equal(t, len(chainInfo.Hops), 2)
+	assert.Nil(t, chainInfo.Hops[0].Err)
+	assert.True(t, errors.Is(chainInfo.Hops[1].Err, ErrDomainDataTruncated))
+	assert.Equal(t, chainInfo.Registrar.Email, "domainabuse@tucows.com")
+	assert.Equal(t, chainInfo.Registrant.Province, "OR")
+	assert.Equal(t, chainInfo.Sources["registrant.province"], "whois.tucows.com")
+
+	chainInfo, err = ParseChain(`No match for "LIKEXIAN-NO-MONEY.COM".`)
+	assert.Equal(t, err, ErrNotFoundDomain)
+	assert.Equal(t, len(chainInfo.Hops), 1)
+
+	_, err = ParseChain()
+	assert.Equal(t, err, ErrDomainDataInvalid)
+}
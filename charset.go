@@ -0,0 +1,198 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+// ParseOption is the option of parsing raw whois response
+type ParseOption func(*parseOptions)
+
+// parseOptions storing the options of parsing raw whois response
+type parseOptions struct {
+	charset   string
+	extension string
+}
+
+// charsetEncoding is a charset with its name
+type charsetEncoding struct {
+	name     string
+	encoding encoding.Encoding
+}
+
+var (
+	shiftJIS = charsetEncoding{"shift_jis", japanese.ShiftJIS}
+	eucJP    = charsetEncoding{"euc-jp", japanese.EUCJP}
+	eucKR    = charsetEncoding{"euc-kr", korean.EUCKR}
+	gb18030  = charsetEncoding{"gb18030", simplifiedchinese.GB18030}
+	big5     = charsetEncoding{"big5", traditionalchinese.Big5}
+	koi8R    = charsetEncoding{"koi8-r", charmap.KOI8R}
+	cp1251   = charsetEncoding{"windows-1251", charmap.Windows1251}
+
+	// defaultCharsets is the charsets tried if there is no extension hint
+	defaultCharsets = []charsetEncoding{
+		shiftJIS, eucJP, eucKR, gb18030, big5, koi8R, cp1251,
+	}
+
+	// extensionCharsets is the charsets tried by extension hint
+	extensionCharsets = map[string][]charsetEncoding{
+		"jp":         {shiftJIS, eucJP},
+		"kr":         {eucKR},
+		"cn":         {gb18030},
+		"xn--fiqs8s": {gb18030},
+		"xn--fiqz9s": {gb18030},
+		"tw":         {big5},
+		"hk":         {big5},
+		"mo":         {big5},
+		"ru":         {koi8R, cp1251},
+		"su":         {koi8R, cp1251},
+		"xn--p1ai":   {koi8R, cp1251},
+		"by":         {cp1251, koi8R},
+		"ua":         {cp1251, koi8R},
+		"xn--j1amh":  {cp1251, koi8R},
+		"kz":         {cp1251, koi8R},
+	}
+)
+
+// utf8BOM is the byte order mark of UTF-8
+var utf8BOM = []byte("\ufeff")
+
+// WithCharset sets the charset of raw whois response, e.g. "Shift_JIS",
+// the charset is detected if it is not set
+func WithCharset(charset string) ParseOption {
+	return func(o *parseOptions) {
+		o.charset = charset
+	}
+}
+
+// WithExtension sets the domain extension as the hint of charset detection,
+// the extension is searched from raw whois response if it is not set
+func WithExtension(extension string) ParseOption {
+	return func(o *parseOptions) {
+		o.extension = extension
+	}
+}
+
+// ParseBytes returns parsed whois info from raw whois response,
+// which is transcoded to UTF-8 by DecodeBytes before parsing
+func ParseBytes(raw []byte, options ...ParseOption) (whoisInfo WhoisInfo, err error) {
+	text, _, err := DecodeBytes(raw, options...)
+	if err != nil {
+		return
+	}
+
+	return Parse(text)
+}
+
+// DecodeBytes returns raw whois response transcoded to UTF-8 and the charset name,
+// the charset is detected with the extension hint if it is not set by WithCharset,
+// the candidate decoding with the fewest invalid or unlikely characters is used.
+func DecodeBytes(raw []byte, options ...ParseOption) (text, charset string, err error) {
+	opts := parseOptions{}
+	for _, o := range options {
+		o(&opts)
+	}
+
+	if opts.charset != "" {
+		enc, err := htmlindex.Get(opts.charset)
+		if err != nil {
+			return "", "", fmt.Errorf("%w: %s", ErrCharsetInvalid, opts.charset)
+		}
+		name, _ := htmlindex.Name(enc)
+		decoded, err := enc.NewDecoder().Bytes(raw)
+		if err != nil {
+			return "", "", fmt.Errorf("%w: %s", ErrCharsetInvalid, err)
+		}
+		return string(bytes.TrimPrefix(decoded, utf8BOM)), name, nil
+	}
+
+	if utf8.Valid(raw) {
+		return string(bytes.TrimPrefix(raw, utf8BOM)), "utf-8", nil
+	}
+
+	extension := strings.ToLower(strings.Trim(opts.extension, "."))
+	if extension == "" {
+		_, extension = searchDomain(string(raw))
+	}
+	if ascii, err := idna.ToASCII(extension); err == nil {
+		extension = ascii
+	}
+
+	candidates, ok := extensionCharsets[extension]
+	if !ok {
+		candidates = defaultCharsets
+	}
+
+	score := -1
+	for _, v := range candidates {
+		decoded, err := v.encoding.NewDecoder().String(string(raw))
+		if err != nil {
+			continue
+		}
+		if s := charsetScore(decoded); score == -1 || s < score {
+			text, charset, score = decoded, v.name, s
+		}
+	}
+
+	if score == -1 {
+		return "", "", ErrCharsetInvalid
+	}
+
+	return text, charset, nil
+}
+
+// charsetScore returns the score of decoded text, lower is more likely,
+// invalid characters, control characters, half-width katakana and
+// uppercase cyrillic letters following lowercase ones are counted
+func charsetScore(text string) int {
+	score := 0
+	last := rune(0)
+
+	for _, v := range text {
+		switch {
+		case v == utf8.RuneError:
+			score += 100
+		case unicode.IsControl(v) && v != '\t' && v != '\n' && v != '\r':
+			score += 10
+		case v >= 0xFF61 && v <= 0xFF9F:
+			score++
+		case unicode.Is(unicode.Cyrillic, v) && unicode.IsUpper(v) &&
+			unicode.Is(unicode.Cyrillic, last) && unicode.IsLower(last):
+			score++
+		}
+		last = v
+	}
+
+	return score
+}
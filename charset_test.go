@@ -0,0 +1,110 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/likexian/gokit/assert"
+	"github.com/likexian/gokit/xfile"
+	"golang.org/x/text/encoding"
+)
+
+func TestDecodeBytes(t *testing.T) {
+	cn, err := xfile.ReadText(noterrorDir + "/cn_google.cn")
+	assert.Nil(t, err)
+
+	tests := []struct {
+		text     string
+		charset  charsetEncoding
+		expected string
+	}{
+		{
+			"Domain Name: example.jp\n[登録者名] 株式会社グーグル\n[Name] Google Japan G.K.\n",
+			shiftJIS, "shift_jis",
+		},
+		{
+			"Domain Name: example.jp\n[登録者名] 株式会社グーグル\n[Name] Google Japan G.K.\n",
+			eucJP, "euc-jp",
+		},
+		{
+			"Domain Name: example.kr\nRegistrant: 구글코리아 유한회사\nRegistrant Address: 서울특별시 강남구\n",
+			eucKR, "euc-kr",
+		},
+		{
+			cn, gb18030, "gb18030",
+		},
+		{
+			"domain: EXAMPLE.TW\nRegistrant:\n台灣谷歌有限公司\nGoogle Taiwan Limited\n",
+			big5, "big5",
+		},
+		{
+			"domain: EXAMPLE.RU\norg: Общество с ограниченной ответственностью Гугл\n",
+			koi8R, "koi8-r",
+		},
+		{
+			"domain: EXAMPLE.UA\norganization: Товариство з обмеженою відповідальністю Гугл\n",
+			cp1251, "windows-1251",
+		},
+	}
+
+	for _, v := range tests {
+		raw, err := v.charset.encoding.NewEncoder().String(v.text)
+		assert.Nil(t, err, v.expected)
+
+		text, charset, err := DecodeBytes([]byte(raw))
+		assert.Nil(t, err, v.expected)
+		assert.Equal(t, charset, v.expected)
+		assert.Equal(t, text, v.text, v.expected)
+
+		expected, expectedErr := Parse(v.text)
+		whoisInfo, err := ParseBytes([]byte(raw))
+		assert.Equal(t, err, expectedErr, v.expected)
+		assert.Equal(t, whoisInfo, expected, v.expected)
+	}
+}
+
+func TestDecodeBytesOption(t *testing.T) {
+	text := "Domain Name: example\n[登録者名] 株式会社グーグル\n"
+	raw, err := encoding.ReplaceUnsupported(eucJP.encoding.NewEncoder()).String(text)
+	assert.Nil(t, err)
+
+	result, charset, err := DecodeBytes([]byte(raw), WithExtension(".JP"))
+	assert.Nil(t, err)
+	assert.Equal(t, charset, "euc-jp")
+	assert.Equal(t, result, text)
+
+	result, charset, err = DecodeBytes([]byte(raw), WithCharset("EUC-JP"))
+	assert.Nil(t, err)
+	assert.Equal(t, charset, "euc-jp")
+	assert.Equal(t, result, text)
+
+	_, _, err = DecodeBytes([]byte(raw), WithCharset("not-a-charset"))
+	assert.True(t, errors.Is(err, ErrCharsetInvalid))
+
+	_, err = ParseBytes([]byte(raw), WithCharset("not-a-charset"))
+	assert.True(t, errors.Is(err, ErrCharsetInvalid))
+
+	result, charset, err = DecodeBytes([]byte("\ufeff" + text))
+	assert.Nil(t, err)
+	assert.Equal(t, charset, "utf-8")
+	assert.Equal(t, result, text)
+}
@@ -0,0 +1,357 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// Precision is the precision of a parsed date
+type Precision int
+
+const (
+	// PrecisionUnknown date is not parsed
+	PrecisionUnknown Precision = iota
+	// PrecisionMonth date has year and month only, e.g. "before Jan-2006"
+	PrecisionMonth
+	// PrecisionDay date has year, month and day only
+	PrecisionDay
+	// PrecisionTime date has full timestamp
+	PrecisionTime
+)
+
+// String returns the name of precision
+func (p Precision) String() string {
+	switch p {
+	case PrecisionMonth:
+		return "month"
+	case PrecisionDay:
+		return "day"
+	case PrecisionTime:
+		return "time"
+	default:
+		return "unknown"
+	}
+}
+
+// dateLayout is a time layout with its precision
+type dateLayout struct {
+	layout    string
+	precision Precision
+}
+
+// defaultDateLayouts is the builtin layouts, date formats containing time
+// components are tried first before attempts are made using date-only formats.
+var defaultDateLayouts = []dateLayout{
+	// Date & time formats
+	{"2006-01-02 15:04:05", PrecisionTime},
+	{"2006.01.02 15:04:05", PrecisionTime},
+	{"02/01/2006 15:04:05", PrecisionTime},
+	{"02.01.2006 15:04:05", PrecisionTime},
+	{"02.1.2006 15:04:05", PrecisionTime},
+	{"2.1.2006 15:04:05", PrecisionTime},
+	{"02-Jan-2006 15:04:05", PrecisionTime},
+	{"2 Jan 2006 15:04:05", PrecisionTime},
+	{"20060102 15:04:05", PrecisionTime},
+	{time.ANSIC, PrecisionTime},
+	{time.Stamp, PrecisionTime},
+	{time.StampMilli, PrecisionTime},
+	{time.StampMicro, PrecisionTime},
+	{time.StampNano, PrecisionTime},
+
+	// Date, time & time zone formats
+	{"2006-01-02T15:04:05Z", PrecisionTime},
+	{"2006-01-02 15:04:05-07", PrecisionTime},
+	{"2006-01-02 15:04:05 MST", PrecisionTime},
+	{"2006-01-02 15:04:05 (MST+3)", PrecisionTime},
+	{"2006/01/02 15:04:05 -0700", PrecisionTime},
+	{time.UnixDate, PrecisionTime},
+	{time.RubyDate, PrecisionTime},
+	{time.RFC822, PrecisionTime},
+	{time.RFC822Z, PrecisionTime},
+	{time.RFC850, PrecisionTime},
+	{time.RFC1123, PrecisionTime},
+	{time.RFC1123Z, PrecisionTime},
+	{time.RFC3339, PrecisionTime},
+	{time.RFC3339Nano, PrecisionTime},
+
+	// Date only formats
+	{"2006-01-02", PrecisionDay},
+	{"02-Jan-2006", PrecisionDay},
+	{"02.01.2006", PrecisionDay},
+	{"02-01-2006", PrecisionDay},
+	{"January _2 2006", PrecisionDay},
+	{"Mon Jan _2 2006", PrecisionDay},
+	{"02/01/2006", PrecisionDay},
+	{"01/02/2006", PrecisionDay},
+	{"2006/01/02", PrecisionDay},
+	{"2006-Jan-02", PrecisionDay},
+	{"2 Jan 2006", PrecisionDay},
+	{"2006 2 Jan", PrecisionDay},
+
+	// Month only formats
+	{"before Jan-2006", PrecisionMonth},
+}
+
+const (
+	// maxDateShapes is the max number of date shapes cached
+	maxDateShapes = 1024
+	// maxDateCache is the max number of date strings cached in a batch
+	maxDateCache = 4096
+)
+
+// DateParser parses date strings from whois information, the layouts matching
+// a date shape are cached, so it is cheap to parse the same date formats repeatedly
+type DateParser struct {
+	mu      sync.RWMutex
+	layouts []dateLayout
+
+	cacheMu sync.Mutex
+	shapes  map[string][]int
+}
+
+// dateResult is the result of parsing a date string
+type dateResult struct {
+	time      time.Time
+	precision Precision
+	err       error
+}
+
+// dateZoneReplacer replaces the time zone names having no standard offset,
+// e.g. "2006/01/02 15:04:05 (JST)" of JPRS => "2006/01/02 15:04:05 +0900"
+var dateZoneReplacer = strings.NewReplacer(" (JST)", " +0900")
+
+// cyrillicMonths is the english months of russian, ukrainian and kazakh month names
+var cyrillicMonths = newCyrillicMonths([][]string{
+	{"января", "январь", "янв", "січня", "січень", "січ", "қаңтар"},
+	{"февраля", "февраль", "фев", "февр", "лютого", "лютий", "лют", "ақпан"},
+	{"марта", "март", "мар", "березня", "березень", "бер", "наурыз"},
+	{"апреля", "апрель", "апр", "квітня", "квітень", "квіт", "сәуір"},
+	{"мая", "май", "травня", "травень", "трав", "мамыр"},
+	{"июня", "июнь", "июн", "червня", "червень", "черв", "маусым"},
+	{"июля", "июль", "июл", "липня", "липень", "лип", "шілде"},
+	{"августа", "август", "авг", "серпня", "серпень", "серп", "тамыз"},
+	{"сентября", "сентябрь", "сен", "сент", "вересня", "вересень", "вер", "қыркүйек"},
+	{"октября", "октябрь", "окт", "жовтня", "жовтень", "жовт", "қазан"},
+	{"ноября", "ноябрь", "ноя", "листопада", "листопад", "лист", "қараша"},
+	{"декабря", "декабрь", "дек", "грудня", "грудень", "груд", "желтоқсан"},
+})
+
+// cyrillicYearWords is the words of year after or before cyrillic dates
+var cyrillicYearWords = map[string]bool{
+	"г": true, "года": true, "р": true, "року": true, "ж": true, "жылғы": true,
+}
+
+// newCyrillicMonths returns the english months of month names, names are in the order of months
+func newCyrillicMonths(names [][]string) map[string]string {
+	result := map[string]string{}
+	for k, v := range names {
+		for _, name := range v {
+			result[name] = time.Month(k + 1).String()[:3]
+		}
+	}
+
+	return result
+}
+
+// defaultDateParser is the date parser used by Parse
+var defaultDateParser = NewDateParser()
+
+// NewDateParser returns a new date parser with the builtin layouts
+func NewDateParser() *DateParser {
+	layouts := make([]dateLayout, len(defaultDateLayouts))
+	copy(layouts, defaultDateLayouts)
+
+	return &DateParser{
+		layouts: layouts,
+	}
+}
+
+// AddLayout registers an extra time layout with its precision,
+// extra layouts are tried after the builtin layouts in registration order.
+func (p *DateParser) AddLayout(layout string, precision Precision) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.layouts = append(p.layouts, dateLayout{layout, precision})
+
+	p.cacheMu.Lock()
+	p.shapes = nil
+	p.cacheMu.Unlock()
+}
+
+// Parse attempts to parse a given date using the registered layouts,
+// returns the parsed time and its precision, or ErrDateInvalid if no layout matches.
+func (p *DateParser) Parse(datetime string) (time.Time, Precision, error) {
+	datetime = normalizeDate(datetime)
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	result := p.parseShape(datetime)
+
+	return result.time, result.precision, result.err
+}
+
+// dateCache caches the results of date strings parsed by the default date parser,
+// it lives as long as a batch, and is reset when maxDateCache date strings are cached
+type dateCache struct {
+	mu      sync.Mutex
+	results map[string]dateResult
+}
+
+// newDateCache returns a new date cache
+func newDateCache() *dateCache {
+	return &dateCache{
+		results: map[string]dateResult{},
+	}
+}
+
+// parse attempts to parse a given date, the result is cached if c is not nil
+func (c *dateCache) parse(datetime string) (time.Time, error) {
+	if c == nil {
+		return parseDateString(datetime)
+	}
+
+	c.mu.Lock()
+	result, ok := c.results[datetime]
+	c.mu.Unlock()
+
+	if !ok {
+		result.time, result.precision, result.err = defaultDateParser.Parse(datetime)
+		c.mu.Lock()
+		if len(c.results) >= maxDateCache {
+			c.results = map[string]dateResult{}
+		}
+		c.results[datetime] = result
+		c.mu.Unlock()
+	}
+
+	return result.time, result.err
+}
+
+// parseShape parses date string with the layouts matching its shape,
+// the layouts are classified and cached when the shape is seen first time
+func (p *DateParser) parseShape(datetime string) dateResult {
+	shape := dateShape(datetime)
+
+	p.cacheMu.Lock()
+	candidates, ok := p.shapes[shape]
+	p.cacheMu.Unlock()
+
+	if !ok {
+		return p.classifyShape(shape, datetime)
+	}
+
+	for _, k := range candidates {
+		if result, err := time.Parse(p.layouts[k].layout, datetime); err == nil {
+			return dateResult{result, p.layouts[k].precision, nil}
+		}
+	}
+
+	return invalidDateResult(datetime)
+}
+
+// classifyShape parses date string with all the layouts in order, the layouts
+// failed for the shape rather than for the values are excluded from the shape
+func (p *DateParser) classifyShape(shape, datetime string) dateResult {
+	result := invalidDateResult(datetime)
+	found := false
+
+	candidates := []int{}
+	for k, v := range p.layouts {
+		parsed, err := time.Parse(v.layout, datetime)
+		if err == nil {
+			if !found {
+				result, found = dateResult{parsed, v.precision, nil}, true
+			}
+			candidates = append(candidates, k)
+			continue
+		}
+		// out of range values may be parsed for another date of the same shape
+		var parseErr *time.ParseError
+		if errors.As(err, &parseErr) && strings.HasSuffix(parseErr.Message, "out of range") {
+			candidates = append(candidates, k)
+		}
+	}
+
+	p.cacheMu.Lock()
+	if p.shapes == nil || len(p.shapes) >= maxDateShapes {
+		p.shapes = map[string][]int{}
+	}
+	p.shapes[shape] = candidates
+	p.cacheMu.Unlock()
+
+	return result
+}
+
+// normalizeDate returns date string normalized for parsing
+func normalizeDate(datetime string) string {
+	datetime = strings.Trim(datetime, ".")
+	datetime = replaceCyrillicMonths(datetime)
+	datetime = strings.ReplaceAll(datetime, ". ", "-")
+
+	return dateZoneReplacer.Replace(datetime)
+}
+
+// replaceCyrillicMonths replaces cyrillic month names with english ones and drops
+// the words of year, e.g. "3 марта 2004 г" => "3 Mar 2004"
+func replaceCyrillicMonths(datetime string) string {
+	if !strings.ContainsFunc(datetime, func(r rune) bool { return unicode.Is(unicode.Cyrillic, r) }) {
+		return datetime
+	}
+
+	fields := []string{}
+	for _, v := range strings.Fields(strings.ToLower(datetime)) {
+		v = strings.TrimSuffix(v, ".")
+		if cyrillicYearWords[v] {
+			continue
+		}
+		if month, ok := cyrillicMonths[v]; ok {
+			v = month
+		}
+		fields = append(fields, v)
+	}
+
+	return strings.Join(fields, " ")
+}
+
+// invalidDateResult returns the result of date string could not be parsed
+func invalidDateResult(datetime string) dateResult {
+	return dateResult{time.Time{}, PrecisionUnknown, fmt.Errorf("%w: %s", ErrDateInvalid, datetime)}
+}
+
+// dateShape returns the shape of date string, which is the string with all
+// digits replaced by 9, e.g. "2006-01-02T15:04:05Z" => "9999-99-99T99:99:99Z"
+func dateShape(datetime string) string {
+	shape := []byte(datetime)
+	for k, v := range shape {
+		if v >= '0' && v <= '9' {
+			shape[k] = '9'
+		}
+	}
+
+	return string(shape)
+}
@@ -0,0 +1,230 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/likexian/gokit/assert"
+)
+
+// parseSequential parses date string by trying all the layouts in order
+func (p *DateParser) parseSequential(datetime string) dateResult {
+	for _, v := range p.layouts {
+		if result, err := time.Parse(v.layout, datetime); err == nil {
+			return dateResult{result, v.precision, nil}
+		}
+	}
+
+	return invalidDateResult(datetime)
+}
+
+func TestDateParser(t *testing.T) {
+	tests := []struct {
+		date      string
+		expected  time.Time
+		precision Precision
+	}{
+		{"2022-12-12T11:01:02Z", time.Date(2022, 12, 12, 11, 1, 2, 0, time.UTC), PrecisionTime},
+		{"2022-12-12 11:40:12", time.Date(2022, 12, 12, 11, 40, 12, 0, time.UTC), PrecisionTime},
+		{"2022-12-03", time.Date(2022, 12, 3, 0, 0, 0, 0, time.UTC), PrecisionDay},
+		{"2022. 12. 01.", time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC), PrecisionDay},
+		{"09-Mar-2023", time.Date(2023, 3, 9, 0, 0, 0, 0, time.UTC), PrecisionDay},
+		{"2023/04/01 01:05:57 (JST)", time.Date(2023, 3, 31, 16, 5, 57, 0, time.UTC), PrecisionTime},
+		{"10 мая 2010 г.", time.Date(2010, 5, 10, 0, 0, 0, 0, time.UTC), PrecisionDay},
+		{"15 березня 2013 р.", time.Date(2013, 3, 15, 0, 0, 0, 0, time.UTC), PrecisionDay},
+		{"3 Сент. 2021", time.Date(2021, 9, 3, 0, 0, 0, 0, time.UTC), PrecisionDay},
+		{"2004 жылғы 3 наурыз", time.Date(2004, 3, 3, 0, 0, 0, 0, time.UTC), PrecisionDay},
+		{"23 апреля 2003 10:17:00", time.Date(2003, 4, 23, 10, 17, 0, 0, time.UTC), PrecisionTime},
+		{"before Aug-1996", time.Date(1996, 8, 1, 0, 0, 0, 0, time.UTC), PrecisionMonth},
+	}
+
+	parser := NewDateParser()
+	for _, v := range tests {
+		result, precision, err := parser.Parse(v.date)
+		assert.Nil(t, err, v.date)
+		assert.True(t, result.Equal(v.expected), v.date)
+		assert.Equal(t, precision, v.precision, v.date)
+	}
+
+	result, precision, err := parser.Parse("not a date")
+	assert.True(t, errors.Is(err, ErrDateInvalid))
+	assert.True(t, result.IsZero())
+	assert.Equal(t, precision, PrecisionUnknown)
+}
+
+func TestDateParserAddLayout(t *testing.T) {
+	parser := NewDateParser()
+
+	_, _, err := parser.Parse("2023年03月09日")
+	assert.True(t, errors.Is(err, ErrDateInvalid))
+
+	parser.AddLayout("2006年01月02日", PrecisionDay)
+	result, precision, err := parser.Parse("2023年03月09日")
+	assert.Nil(t, err)
+	assert.True(t, result.Equal(time.Date(2023, 3, 9, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, precision, PrecisionDay)
+
+	// extra layouts must not leak into other parsers
+	_, _, err = NewDateParser().Parse("2023年03月09日")
+	assert.True(t, errors.Is(err, ErrDateInvalid))
+	_, err = parseDateString("2023年03月09日")
+	assert.True(t, errors.Is(err, ErrDateInvalid))
+}
+
+func TestPrecisionString(t *testing.T) {
+	assert.Equal(t, PrecisionUnknown.String(), "unknown")
+	assert.Equal(t, PrecisionMonth.String(), "month")
+	assert.Equal(t, PrecisionDay.String(), "day")
+	assert.Equal(t, PrecisionTime.String(), "time")
+}
+
+func TestDateParserShape(t *testing.T) {
+	dates := []string{
+		// day first layout is out of range, month first layout matches
+		"05/13/2020",
+		// day first layout matches
+		"05/06/2020",
+		"13/05/2020",
+		"2022. 12. 01.",
+		"not a date",
+		"before Aug-1996",
+		"before Jan-1996",
+	}
+
+	for _, v := range readCorpus(t, noterrorDir) {
+		whoisInfo, err := Parse(v)
+		assert.Nil(t, err)
+		dates = append(dates, whoisInfo.Domain.CreatedDate, whoisInfo.Domain.UpdatedDate,
+			whoisInfo.Domain.ExpirationDate)
+	}
+
+	parser := NewDateParser()
+	for i := 0; i < 2; i++ {
+		for _, v := range dates {
+			result, precision, err := parser.Parse(v)
+			exp := parser.parseSequential(normalizeDate(v))
+			assert.Equal(t, result, exp.time, v)
+			assert.Equal(t, precision, exp.precision, v)
+			assert.Equal(t, err, exp.err, v)
+		}
+	}
+
+	assert.Equal(t, dateShape("2006-01-02T15:04:05Z"), "9999-99-99T99:99:99Z")
+}
+
+func TestDateCache(t *testing.T) {
+	dates := newDateCache()
+	for i := 0; i < 2; i++ {
+		for _, v := range []string{"2022-12-03", "2022. 12. 01.", "not a date"} {
+			result, err := dates.parse(v)
+			exp, expErr := parseDateString(v)
+			assert.Equal(t, result, exp, v)
+			assert.Equal(t, err, expErr, v)
+		}
+	}
+	assert.Equal(t, len(dates.results), 3)
+
+	for i := 0; i < maxDateCache; i++ {
+		_, _ = dates.parse(fmt.Sprintf("2022-12-03 %d", i))
+	}
+	assert.True(t, len(dates.results) <= maxDateCache)
+
+	var none *dateCache
+	result, err := none.parse("2022-12-03")
+	assert.Nil(t, err)
+	assert.Equal(t, result.Format(time.DateOnly), "2022-12-03")
+}
+
+// benchDates returns the dates of corpus by extension
+func benchDates(b *testing.B) map[string][]string {
+	groups := map[string][]string{}
+	for k, v := range readCorpus(b, noterrorDir) {
+		whoisInfo, err := Parse(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for _, date := range []string{
+			whoisInfo.Domain.CreatedDate,
+			whoisInfo.Domain.UpdatedDate,
+			whoisInfo.Domain.ExpirationDate,
+		} {
+			if date != "" {
+				groups[k.extension] = append(groups[k.extension], date)
+			}
+		}
+	}
+
+	// the worst case which tries all the layouts
+	groups["invalid"] = []string{"not a date"}
+
+	return groups
+}
+
+// BenchmarkParseDateString parses dates with the layouts of shapes cached
+func BenchmarkParseDateString(b *testing.B) {
+	groups := benchDates(b)
+	for _, extension := range sortedKeys(groups) {
+		b.Run(benchName(extension), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, v := range groups[extension] {
+					_, _ = parseDateString(v)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkParseDateStringCold parses dates with a new date parser, so no shape is cached
+func BenchmarkParseDateStringCold(b *testing.B) {
+	groups := benchDates(b)
+	for _, extension := range sortedKeys(groups) {
+		b.Run(benchName(extension), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				parser := NewDateParser()
+				for _, v := range groups[extension] {
+					_, _, _ = parser.Parse(v)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkDateCache parses dates with the date cache of batch
+func BenchmarkDateCache(b *testing.B) {
+	groups := benchDates(b)
+	dates := newDateCache()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, v := range groups {
+			for _, date := range v {
+				_, _ = dates.parse(date)
+			}
+		}
+	}
+}
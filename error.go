@@ -21,7 +21,10 @@ package whoisparser
 
 import (
 	"errors"
+	"fmt"
+	"path/filepath"
 	"regexp"
+	"runtime"
 	"strings"
 )
 
@@ -38,29 +41,82 @@ var (
 	ErrDomainDataInvalid = errors.New("whoisparser: domain whois data is invalid")
 	// ErrDomainLimitExceed domain whois query is limited
 	ErrDomainLimitExceed = errors.New("whoisparser: domain whois query limit exceeded")
+	// ErrPendingDeleteDomain domain is pending delete
+	ErrPendingDeleteDomain = errors.New("whoisparser: domain is pending delete")
+	// ErrRedemptionDomain domain is in redemption period
+	ErrRedemptionDomain = errors.New("whoisparser: domain is in redemption period")
+	// ErrQuarantineDomain domain is in quarantine
+	ErrQuarantineDomain = errors.New("whoisparser: domain is in quarantine")
+	// ErrWithheldDomain domain is withheld from registration
+	ErrWithheldDomain = errors.New("whoisparser: domain is withheld from registration")
+	// ErrUnavailableDomain domain is not available for registration by policy
+	ErrUnavailableDomain = errors.New("whoisparser: domain is not available for registration by policy")
+	// ErrDomainDataTruncated domain whois data is truncated
+	ErrDomainDataTruncated = errors.New("whoisparser: domain whois data is truncated")
+	// ErrDateInvalid date string can not be parsed
+	ErrDateInvalid = errors.New("whoisparser: could not parse as a date")
+	// ErrCharsetInvalid charset is not supported or could not be detected
+	ErrCharsetInvalid = errors.New("whoisparser: charset is not supported")
+	// ErrParserPanic parser panicked on unexpected whois data
+	ErrParserPanic = errors.New("whoisparser: parser panicked on unexpected data")
 )
 
-// getDomainErrorType returns error type of domain data
-func getDomainErrorType(data string) error {
-	switch {
-	case isNotFoundDomain(data):
-		return ErrNotFoundDomain
-	case isBlockedDomain(data):
-		return ErrBlockedDomain
-	case isPremiumDomain(data):
-		return ErrPremiumDomain
-	case isReservedDomain(data):
-		return ErrReservedDomain
-	case isLimitExceeded(data):
-		return ErrDomainLimitExceed
-	default:
-		return ErrDomainDataInvalid
+// maxPanicFrames is the max stack frames in parser panic error
+const maxPanicFrames = 8
+
+// newParserPanicError returns parser panic error with extension and stack summary,
+// it must be called from the deferred function which recovered the panic
+func newParserPanicError(extension string, recovered any) error {
+	return fmt.Errorf("%w: extension %q: %v at %s", ErrParserPanic, extension, recovered, panicStackSummary())
+}
+
+// panicStackSummary returns the frames of this package where panic occurred,
+// e.g. "prepareUA (prepare.go:917) <- Prepare (prepare.go:72) <- Parse (parser.go:70)"
+func panicStackSummary() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+
+	pkgPath, _ := splitFuncName(runtime.FuncForPC(pcs[0]).Name())
+
+	result := []string{}
+	panicked := false
+	for {
+		frame, more := frames.Next()
+		pkg, name := splitFuncName(frame.Function)
+		switch {
+		case pkg == "runtime":
+			panicked = true
+		case panicked && pkg == pkgPath:
+			result = append(result, fmt.Sprintf("%s (%s:%d)", name, filepath.Base(frame.File), frame.Line))
+		case panicked && len(result) > 0:
+			more = false
+		}
+		if !more || len(result) == maxPanicFrames {
+			break
+		}
+	}
+
+	if len(result) == 0 {
+		return "unknown"
 	}
+
+	return strings.Join(result, " <- ")
 }
 
-// isNotFoundDomain returns if domain is not found
-func isNotFoundDomain(data string) bool {
-	notFoundKeys := []string{
+// splitFuncName returns package path and function name of full function name,
+// e.g. "github.com/likexian/whois-parser.Parse.func1"
+func splitFuncName(name string) (pkg, fn string) {
+	pos := strings.LastIndex(name, "/") + 1
+	if dot := strings.Index(name[pos:], "."); dot != -1 {
+		return name[:pos+dot], name[pos+dot+1:]
+	}
+
+	return name, ""
+}
+
+var (
+	// notFoundKeys is the phrases of domain not found
+	notFoundKeys = []string{
 		"is free",
 		"no found",
 		"no match",
@@ -78,79 +134,21 @@ func isNotFoundDomain(data string) bool {
 		"domain name not known",
 	}
 
-	return containsIn(strings.ToLower(data), notFoundKeys)
-}
-
-var reBlank = regexp.MustCompile(`\s+`)
-
-// isExtNotFoundDomain returns if domain is not found by extension
-func isExtNotFoundDomain(data, extension string) bool {
-	data = reBlank.ReplaceAllString(data, " ")
-
-	switch extension {
-	case "ai", "cx", "gs":
-		if strings.Contains(data, "Domain Status: No Object Found") {
-			return true
-		}
-	case "de":
-		if strings.Contains(data, "Status: free") {
-			return true
-		}
-	case "eu", "it":
-		if strings.Contains(data, "Status: AVAILABLE") {
-			return true
-		}
-	case "nz":
-		if strings.Contains(data, "query_status: 220 Available") {
-			return true
-		}
-	case "pl":
-		if strings.Contains(data, "No information available about domain name") {
-			return true
-		}
-	case "sexy":
-		if strings.Contains(data, "is available") {
-			return true
-		}
-	case "love":
-		if strings.Contains(data, "is available") {
-			return true
-		}
-	case "nu":
-		fallthrough
-	case "se":
-		if strings.Contains(data, "not found") {
-			return true
-		}
-	}
-
-	return false
-}
-
-// isReservedDomain returns if domain is reserved
-func isReservedDomain(data string) bool {
-	reservedKeys := []string{
+	// reservedKeys is the phrases of domain reserved
+	reservedKeys = []string{
 		"reserved domain name",
 		"reserved by the registry",
 		"can not be registered online",
 	}
 
-	return containsIn(strings.ToLower(data), reservedKeys)
-}
-
-// isPremiumDomain returns if domain is available to register at premium price
-func isPremiumDomain(data string) bool {
-	premiumKeys := []string{
+	// premiumKeys is the phrases of domain available at premium price
+	premiumKeys = []string{
 		"premium domain is available for purchase",
 		"platinum domain is available for purchase",
 	}
 
-	return containsIn(strings.ToLower(data), premiumKeys)
-}
-
-// isBlockedDomain returns if domain is blocked due to brand protection
-func isBlockedDomain(data string) bool {
-	blockedKeys := []string{
+	// blockedKeys is the phrases of domain blocked due to brand protection
+	blockedKeys = []string{
 		// Donuts DPML
 		"dpml brand protection",
 		// Uniregistry Uni EPS
@@ -159,12 +157,8 @@ func isBlockedDomain(data string) bool {
 		"subscribes to the adultblock",
 	}
 
-	return containsIn(strings.ToLower(data), blockedKeys)
-}
-
-// isLimitExceeded returns if domain whois query is limited
-func isLimitExceeded(data string) bool {
-	limitExceedKeys := []string{
+	// limitExceedKeys is the phrases of whois query limited
+	limitExceedKeys = []string{
 		"limit exceeded",
 		"server too busy",
 		"quota exceeded",
@@ -176,7 +170,224 @@ func isLimitExceeded(data string) bool {
 		"maximum daily connection limit reached",
 		"maximum query rate reached",
 		"number of allowed queries exceeded",
+		"too many requests",
+	}
+
+	// pendingDeleteKeys is the phrases of domain pending delete
+	pendingDeleteKeys = []string{
+		"pending delete",
+		"pendingdelete",
+		"pending deletion",
+		"scheduled for deletion",
+	}
+
+	// redemptionKeys is the phrases of domain in redemption period
+	redemptionKeys = []string{
+		"redemption period",
+		"redemptionperiod",
+		"redemption grace period",
+		"in redemption",
+	}
+
+	// quarantineKeys is the phrases of domain in quarantine
+	quarantineKeys = []string{
+		"in quarantine",
+		"is quarantined",
+		"quarantine period",
+	}
+
+	// withheldKeys is the phrases of domain withheld from registration
+	withheldKeys = []string{
+		"domain is withheld",
+		"name is withheld",
+		"status: withheld",
+		"withheld from registration",
+	}
+
+	// unavailableKeys is the phrases of domain not available for registration by policy
+	unavailableKeys = []string{
+		"not available for registration due to policy",
+		"not available for registration per policy",
+		"not allowed by registry policy",
+		"prohibited by registry policy",
+		"restricted by registry policy",
+	}
+)
+
+// domainErrorRule is the phrases of domain data for an error
+type domainErrorRule struct {
+	err  error
+	keys []string
+}
+
+// domainErrorRules is the rules of domain data, the first matched is used,
+// not found is matched before the domain states since its notices may mention them,
+// but after the policy phrases which contain the "not available" of not found
+var domainErrorRules = []domainErrorRule{
+	{ErrUnavailableDomain, unavailableKeys},
+	{ErrNotFoundDomain, notFoundKeys},
+	{ErrPendingDeleteDomain, pendingDeleteKeys},
+	{ErrRedemptionDomain, redemptionKeys},
+	{ErrQuarantineDomain, quarantineKeys},
+	{ErrWithheldDomain, withheldKeys},
+	{ErrBlockedDomain, blockedKeys},
+	{ErrPremiumDomain, premiumKeys},
+	{ErrReservedDomain, reservedKeys},
+	{ErrDomainLimitExceed, limitExceedKeys},
+}
+
+// statusDomainRules is the rules of domain status values, the first matched is used,
+// redemption is matched first since a domain in redemption period is pending delete too
+var statusDomainRules = []domainErrorRule{
+	{ErrRedemptionDomain, []string{"redemptionperiod"}},
+	{ErrPendingDeleteDomain, []string{"pendingdelete"}},
+}
+
+// statusReplacer removes the separators of domain status value
+var statusReplacer = strings.NewReplacer(" ", "", "_", "", "-", "")
+
+// extDomainRule is the phrase of domain data for an error by extension
+type extDomainRule struct {
+	key string
+	err error
+}
+
+// extDomainRules is the rules of domain data by extension, the first matched is used
+var extDomainRules = map[string][]extDomainRule{
+	"ai":   {{"Domain Status: No Object Found", ErrNotFoundDomain}},
+	"cx":   {{"Domain Status: No Object Found", ErrNotFoundDomain}},
+	"gs":   {{"Domain Status: No Object Found", ErrNotFoundDomain}},
+	"de":   {{"Status: free", ErrNotFoundDomain}},
+	"nz":   {{"query_status: 220 Available", ErrNotFoundDomain}},
+	"pl":   {{"No information available about domain name", ErrNotFoundDomain}},
+	"sexy": {{"is available", ErrNotFoundDomain}},
+	"love": {{"is available", ErrNotFoundDomain}},
+	"nu":   {{"not found", ErrNotFoundDomain}},
+	"se":   {{"not found", ErrNotFoundDomain}},
+	"eu": {
+		{"Status: AVAILABLE", ErrNotFoundDomain},
+		{"Status: IN QUARANTINE", ErrQuarantineDomain},
+		{"Status: WITHHELD", ErrWithheldDomain},
+		{"Status: NOT ALLOWED", ErrUnavailableDomain},
+	},
+	"it": {
+		{"Status: AVAILABLE", ErrNotFoundDomain},
+		{"Status: pendingDelete / redemptionPeriod", ErrRedemptionDomain},
+		{"Status: pendingDelete / pendingDelete", ErrPendingDeleteDomain},
+		{"Status: UNASSIGNABLE", ErrWithheldDomain},
+	},
+	"nl": {{"Status: in quarantine", ErrQuarantineDomain}},
+	"be": {{"Flags: quarantine", ErrQuarantineDomain}},
+}
+
+// getDomainErrorType returns error type of domain data,
+// a *RateLimitError wrapping ErrDomainLimitExceed is returned if query is limited
+func getDomainErrorType(data string) error {
+	rule, _ := searchDomainError(data)
+	switch {
+	case rule.err == nil:
+		return ErrDomainDataInvalid
+	case errors.Is(rule.err, ErrDomainLimitExceed):
+		return newRateLimitError(data)
+	default:
+		return rule.err
+	}
+}
+
+// searchDomainError returns the rule matched by domain data and the line matched
+func searchDomainError(data string) (rule domainErrorRule, evidence string) {
+	for _, v := range domainErrorRules {
+		if evidence = searchIn(data, v.keys); evidence != "" {
+			return v, evidence
+		}
 	}
 
-	return containsIn(strings.ToLower(data), limitExceedKeys)
+	return
+}
+
+// searchStatusDomainError returns the rule matched by the domain status lines
+// of domain data and the line matched, e.g. "Domain Status: pendingDelete"
+func searchStatusDomainError(data string) (rule domainErrorRule, evidence string) {
+	lines := []string{}
+	values := []string{}
+
+	scanner := lineScanner{text: data}
+	for {
+		line, ok := scanner.next()
+		if !ok {
+			break
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if ok && keyRule[clearKeyName(name)] == "domain_status" {
+			lines = append(lines, strings.TrimSpace(line))
+			values = append(values, statusReplacer.Replace(strings.ToLower(value)))
+		}
+	}
+
+	for _, v := range statusDomainRules {
+		for k, value := range values {
+			if containsIn(value, v.keys) {
+				return v, lines[k]
+			}
+		}
+	}
+
+	return domainErrorRule{}, ""
+}
+
+// isNotFoundDomain returns if domain is not found
+func isNotFoundDomain(data string) bool {
+	return searchIn(data, notFoundKeys) != ""
+}
+
+var reBlank = regexp.MustCompile(`\s+`)
+
+// isExtNotFoundDomain returns if domain is not found by extension
+func isExtNotFoundDomain(data, extension string) bool {
+	rule := searchExtDomainError(data, extension)
+	return errors.Is(rule.err, ErrNotFoundDomain)
+}
+
+// getExtDomainErrorType returns error type of domain data by extension,
+// nil is returned if there is no error
+func getExtDomainErrorType(data, extension string) error {
+	return searchExtDomainError(data, extension).err
+}
+
+// searchExtDomainError returns the rule matched by domain data of extension
+func searchExtDomainError(data, extension string) extDomainRule {
+	rules, ok := extDomainRules[extension]
+	if !ok {
+		return extDomainRule{}
+	}
+
+	data = reBlank.ReplaceAllString(data, " ")
+
+	for _, v := range rules {
+		if strings.Contains(data, v.key) {
+			return v
+		}
+	}
+
+	return extDomainRule{}
+}
+
+// isReservedDomain returns if domain is reserved
+func isReservedDomain(data string) bool {
+	return searchIn(data, reservedKeys) != ""
+}
+
+// isPremiumDomain returns if domain is available to register at premium price
+func isPremiumDomain(data string) bool {
+	return searchIn(data, premiumKeys) != ""
+}
+
+// isBlockedDomain returns if domain is blocked due to brand protection
+func isBlockedDomain(data string) bool {
+	return searchIn(data, blockedKeys) != ""
+}
+
+// isLimitExceeded returns if domain whois query is limited
+func isLimitExceeded(data string) bool {
+	return searchIn(data, limitExceedKeys) != ""
 }
@@ -20,6 +20,8 @@
 package whoisparser
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/likexian/gokit/assert"
@@ -142,3 +144,118 @@ func TestAsisLimitExceeded(t *testing.T) {
 	data = "Number of allowed queries exceeded\r\n"
 	assert.True(t, isLimitExceeded(data))
 }
+
+func TestAsisPendingDeleteDomain(t *testing.T) {
+	data := `This domain name is pending delete and will be released soon.`
+	assert.Equal(t, getDomainErrorType(data), ErrPendingDeleteDomain)
+
+	data = `Domain Status: pendingDelete https://icann.org/epp#pendingDelete`
+	assert.Equal(t, getDomainErrorType(data), ErrPendingDeleteDomain)
+
+	// likexian.com
+	data = `Domain Name: LIKEXIAN.COM
+	Registry Domain ID: 1665843940_DOMAIN_COM-VRSN`
+	assert.NotEqual(t, getDomainErrorType(data), ErrPendingDeleteDomain)
+}
+
+func TestAsisRedemptionDomain(t *testing.T) {
+	data := `Domain Status: redemptionPeriod https://icann.org/epp#redemptionPeriod`
+	assert.Equal(t, getDomainErrorType(data), ErrRedemptionDomain)
+
+	data = `The domain is in redemption grace period, it may be restored by the registrant.`
+	assert.Equal(t, getDomainErrorType(data), ErrRedemptionDomain)
+
+	data = `No match for "EXAMPLE.COM".
+	Deleted domains may be restored during the redemption period.`
+	assert.Equal(t, getDomainErrorType(data), ErrNotFoundDomain)
+
+	// likexian.com
+	data = `Domain Name: LIKEXIAN.COM
+	Registry Domain ID: 1665843940_DOMAIN_COM-VRSN`
+	assert.NotEqual(t, getDomainErrorType(data), ErrRedemptionDomain)
+}
+
+func TestParseStatusDomain(t *testing.T) {
+	whoisRaw, err := xfile.ReadText(noterrorDir + "/com_google.com")
+	assert.Nil(t, err)
+
+	tests := map[string]error{
+		"Domain Status: pendingDelete https://icann.org/epp#pendingDelete":       ErrPendingDeleteDomain,
+		"Domain Status: redemptionPeriod https://icann.org/epp#redemptionPeriod": ErrRedemptionDomain,
+		"Domain Status: pendingDelete\nDomain Status: redemptionPeriod":          ErrRedemptionDomain,
+	}
+
+	for status, exp := range tests {
+		data := strings.Replace(whoisRaw,
+			"Domain Status: clientUpdateProhibited (https://www.icann.org/epp#clientUpdateProhibited)", status, 1)
+		whoisInfo, err := Parse(data)
+		assert.Equal(t, err, exp, status)
+		assert.Equal(t, whoisInfo.Domain.Domain, "google.com", status)
+	}
+
+	lines := strings.Split(strings.Replace(whoisRaw,
+		"Domain Status: clientUpdateProhibited", "Domain Status: pendingDelete", 1), "\n")
+	_, err = Parse(strings.Join(lines[:30], "\n"))
+	assert.True(t, errors.Is(err, ErrPendingDeleteDomain))
+	assert.True(t, errors.Is(err, ErrDomainDataTruncated))
+
+	_, err = Parse(whoisRaw)
+	assert.Nil(t, err)
+}
+
+func TestAsisQuarantineDomain(t *testing.T) {
+	data := `example.nl is in quarantine`
+	assert.Equal(t, getDomainErrorType(data), ErrQuarantineDomain)
+
+	// likexian.com
+	data = `Domain Name: LIKEXIAN.COM
+	Registry Domain ID: 1665843940_DOMAIN_COM-VRSN`
+	assert.NotEqual(t, getDomainErrorType(data), ErrQuarantineDomain)
+}
+
+func TestAsisWithheldDomain(t *testing.T) {
+	data := `This domain name is withheld from registration.`
+	assert.Equal(t, getDomainErrorType(data), ErrWithheldDomain)
+
+	// Donuts terms of use
+	data = `Access to non-public data may be provided, upon request, where it can be reasonably
+	confirmed that the requester holds a specific legitimate interest and a proper legal basis
+	for accessing the withheld data.`
+	assert.NotEqual(t, getDomainErrorType(data), ErrWithheldDomain)
+}
+
+func TestAsisUnavailableDomain(t *testing.T) {
+	data := `This name is not available for registration due to policy restrictions.`
+	assert.Equal(t, getDomainErrorType(data), ErrUnavailableDomain)
+
+	data = `Not available for registration.
+	Second level domain name is reserved.`
+	assert.NotEqual(t, getDomainErrorType(data), ErrUnavailableDomain)
+}
+
+func TestAsisExtDomainErrorType(t *testing.T) {
+	tests := []struct {
+		data      string
+		extension string
+		err       error
+	}{
+		{"Domain: example.eu\nScript: LATIN\n\nStatus: IN QUARANTINE", "eu", ErrQuarantineDomain},
+		{"Domain: example.eu\nScript: LATIN\n\nStatus: WITHHELD", "eu", ErrWithheldDomain},
+		{"Domain: example.eu\nScript: LATIN\n\nStatus: NOT ALLOWED", "eu", ErrUnavailableDomain},
+		{"Domain: example.eu\nScript: LATIN\n\nStatus: AVAILABLE", "eu", ErrNotFoundDomain},
+		{"Domain:             example.it\nStatus:             pendingDelete / redemptionPeriod", "it", ErrRedemptionDomain},
+		{"Domain:             example.it\nStatus:             pendingDelete / pendingDelete", "it", ErrPendingDeleteDomain},
+		{"Domain:             example.it\nStatus:             UNASSIGNABLE", "it", ErrWithheldDomain},
+		{"Domain name: example.nl\nStatus: in quarantine", "nl", ErrQuarantineDomain},
+		{"Domain: example.be\nStatus: NOT AVAILABLE\n\nFlags:\n\tquarantine", "be", ErrQuarantineDomain},
+		{"Domain: example.be\nStatus: NOT AVAILABLE", "be", nil},
+	}
+
+	for _, v := range tests {
+		assert.Equal(t, getExtDomainErrorType(v.data, v.extension), v.err, v.data)
+		_, err := Parse(v.data)
+		if v.err != nil {
+			assert.Equal(t, err, v.err, v.data)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/likexian/gokit/assert"
+	"github.com/likexian/gokit/xfile"
+	"golang.org/x/net/idna"
+)
+
+// readCorpus returns the whois raw text of files in dir
+func readCorpus(t testing.TB, dir string) map[corpusFile]string {
+	t.Helper()
+
+	result := map[corpusFile]string{}
+
+	for _, v := range listCorpus(t, dir) {
+		whoisRaw, err := xfile.ReadText(dir + "/" + v.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result[v] = whoisRaw
+	}
+
+	return result
+}
+
+// preparedExtensions returns the sorted extensions which have a preparer
+func preparedExtensions(corpus map[corpusFile]string) []string {
+	result := []string{}
+
+	for v := range corpus {
+		if _, prepared := Prepare("", v.extension); prepared && !assert.IsContains(result, v.extension) {
+			result = append(result, v.extension)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// assertUnique asserts there is no duplicated value
+func assertUnique(t *testing.T, values []string, name string) {
+	seen := map[string]bool{}
+	for _, v := range values {
+		if seen[v] {
+			t.Fatalf("%s has duplicated value %q", name, v)
+		}
+		seen[v] = true
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	for _, dir := range []string{noterrorDir, notfoundDir} {
+		for _, v := range readCorpus(f, dir) {
+			f.Add(v)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		whoisInfo, err := Parse(text)
+		if errors.Is(err, ErrParserPanic) {
+			t.Fatal(err)
+		}
+		if err != nil {
+			return
+		}
+
+		if whoisInfo.Domain == nil {
+			t.Fatal("domain is nil without error")
+		}
+
+		punycode := whoisInfo.Domain.Punycode
+		if punycode != "" {
+			if again, err := idna.ToASCII(punycode); err == nil && again != punycode {
+				t.Fatalf("punycode is not idempotent: %q => %q", punycode, again)
+			}
+		}
+
+		assertUnique(t, whoisInfo.Domain.Status, "status")
+		assertUnique(t, whoisInfo.Domain.NameServers, "name servers")
+
+		for _, v := range whoisInfo.Domain.NameServers {
+			if v != strings.ToLower(v) || strings.HasPrefix(v, ".") || strings.HasSuffix(v, ".") {
+				t.Fatalf("name server is not normalized: %q", v)
+			}
+		}
+	})
+}
+
+func FuzzPrepare(f *testing.F) {
+	corpus := readCorpus(f, noterrorDir)
+	extensions := preparedExtensions(corpus)
+
+	for v, text := range corpus {
+		for k, ext := range extensions {
+			if ext == v.extension {
+				f.Add(text, uint(k))
+			}
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, text string, index uint) {
+		ext := extensions[index%uint(len(extensions))]
+		if _, prepared := Prepare(text, ext); !prepared {
+			t.Fatalf("extension %q is not prepared", ext)
+		}
+	})
+}
+
+func FuzzParseDateString(f *testing.F) {
+	for _, v := range readCorpus(f, noterrorDir) {
+		whoisInfo, err := Parse(v)
+		if err != nil {
+			continue
+		}
+		for _, date := range []string{
+			whoisInfo.Domain.CreatedDate,
+			whoisInfo.Domain.UpdatedDate,
+			whoisInfo.Domain.ExpirationDate,
+		} {
+			if date != "" {
+				f.Add(date)
+			}
+		}
+	}
+
+	parser := NewDateParser()
+	f.Fuzz(func(t *testing.T, date string) {
+		result, precision, err := parser.Parse(date)
+		exp := parser.parseSequential(normalizeDate(date))
+		if !result.Equal(exp.time) || precision != exp.precision {
+			t.Fatalf("shape matching is not the same as sequential parsing: %q", date)
+		}
+
+		if err != nil {
+			if !result.IsZero() || precision != PrecisionUnknown {
+				t.Fatalf("failed parsing returns value: %v %v", result, precision)
+			}
+			return
+		}
+
+		if precision == PrecisionUnknown {
+			t.Fatalf("parsed date %q has unknown precision", date)
+		}
+
+		if again, err := parseDateString(date); err != nil || !again.Equal(result) {
+			t.Fatalf("parseDateString is not the same as DateParser: %q", date)
+		}
+	})
+}
@@ -5,6 +5,5 @@ go 1.24.0
 require (
 	github.com/likexian/gokit v0.25.16
 	golang.org/x/net v0.48.0
+	golang.org/x/text v0.32.0
 )
-
-require golang.org/x/text v0.32.0 // indirect
@@ -0,0 +1,302 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/likexian/gokit/assert"
+	"github.com/likexian/gokit/xfile"
+	"github.com/likexian/gokit/xjson"
+)
+
+// update golden files by go test -update
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+// corpusFile is a whois raw file in testdata
+type corpusFile struct {
+	name      string
+	domain    string
+	extension string
+}
+
+// listCorpus returns the whois raw files in dir, golden files are excluded
+func listCorpus(t testing.TB, dir string) []corpusFile {
+	t.Helper()
+
+	dirs, err := xfile.ListDir(dir, xfile.TypeFile, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := []corpusFile{}
+	for _, v := range dirs {
+		if v.Name == "README.md" || strings.HasSuffix(v.Name, ".pre") || strings.HasSuffix(v.Name, ".json") {
+			continue
+		}
+
+		domain := strings.Split(v.Name, "_")[1]
+		extension := ""
+		if strings.Contains(v.Name, ".") {
+			extension = domain[strings.LastIndex(domain, ".")+1:]
+		}
+
+		result = append(result, corpusFile{
+			name:      v.Name,
+			domain:    domain,
+			extension: extension,
+		})
+	}
+
+	return result
+}
+
+// groupCorpus returns the whois raw text grouped by extension
+func groupCorpus(corpus map[corpusFile]string) map[string][]string {
+	result := map[string][]string{}
+
+	for k, v := range corpus {
+		result[k.extension] = append(result[k.extension], v)
+	}
+
+	return result
+}
+
+// benchName returns the sub-benchmark name of extension,
+// the tld whois info from IANA is named "tld"
+func benchName(extension string) string {
+	if extension == "" {
+		return "tld"
+	}
+
+	return extension
+}
+
+// sortedKeys returns the sorted keys of grouped corpus
+func sortedKeys(groups map[string][]string) []string {
+	result := []string{}
+	for k := range groups {
+		result = append(result, k)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// assertGoldenText asserts text is the same as the golden file,
+// the golden file is written instead if -update is set
+func assertGoldenText(t *testing.T, path, got string) {
+	t.Helper()
+
+	if *update {
+		assert.Nil(t, xfile.WriteText(path, got))
+		return
+	}
+
+	if !xfile.Exists(path) {
+		t.Errorf("golden file %s is missing, run go test -update", path)
+		return
+	}
+
+	exp, err := xfile.ReadText(path)
+	assert.Nil(t, err)
+
+	if exp != got {
+		t.Errorf("golden file %s is stale, run go test -update\n%s", path, diffText(exp, got))
+	}
+}
+
+// assertGoldenJSON asserts data is the same as the golden json file,
+// the golden file is written instead if -update is set
+func assertGoldenJSON(t *testing.T, path string, data any) {
+	t.Helper()
+
+	if *update {
+		assert.Nil(t, xjson.Dump(path, data))
+		return
+	}
+
+	if !xfile.Exists(path) {
+		t.Errorf("golden file %s is missing, run go test -update", path)
+		return
+	}
+
+	exp, err := xfile.ReadText(path)
+	assert.Nil(t, err)
+
+	got, err := json.Marshal(data)
+	assert.Nil(t, err)
+
+	diffs, err := diffJSON(exp, string(got))
+	assert.Nil(t, err, path)
+
+	if len(diffs) > 0 {
+		t.Errorf("golden file %s is stale, run go test -update\n%s", path, strings.Join(diffs, "\n"))
+	}
+}
+
+// diffText returns the first different line of two text
+func diffText(exp, got string) string {
+	expLines := strings.Split(exp, "\n")
+	gotLines := strings.Split(got, "\n")
+
+	for i := 0; i < len(expLines) || i < len(gotLines); i++ {
+		e, g := "<none>", "<none>"
+		if i < len(expLines) {
+			e = expLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if e != g {
+			return fmt.Sprintf("line %d:\n- %s\n+ %s", i+1, e, g)
+		}
+	}
+
+	return ""
+}
+
+// diffJSON returns the different fields of two json, one line per field,
+// e.g. domain.status[0]: "ok" => "active"
+func diffJSON(exp, got string) ([]string, error) {
+	var expData, gotData any
+
+	if err := json.Unmarshal([]byte(exp), &expData); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(got), &gotData); err != nil {
+		return nil, err
+	}
+
+	expFields := map[string]string{}
+	flattenJSON("", expData, expFields)
+
+	gotFields := map[string]string{}
+	flattenJSON("", gotData, gotFields)
+
+	names := []string{}
+	for k := range expFields {
+		names = append(names, k)
+	}
+	for k := range gotFields {
+		if _, ok := expFields[k]; !ok {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+
+	diffs := []string{}
+	for _, k := range names {
+		e, ok := expFields[k]
+		if !ok {
+			e = "<none>"
+		}
+		g, ok := gotFields[k]
+		if !ok {
+			g = "<none>"
+		}
+		if e != g {
+			diffs = append(diffs, fmt.Sprintf("%s: %s => %s", k, e, g))
+		}
+	}
+
+	return diffs, nil
+}
+
+// flattenJSON flattens json data to field path and value
+func flattenJSON(prefix string, data any, result map[string]string) {
+	switch v := data.(type) {
+	case map[string]any:
+		for k, vv := range v {
+			name := k
+			if prefix != "" {
+				name = prefix + "." + k
+			}
+			flattenJSON(name, vv, result)
+		}
+	case []any:
+		for k, vv := range v {
+			flattenJSON(fmt.Sprintf("%s[%d]", prefix, k), vv, result)
+		}
+	default:
+		value, _ := json.Marshal(v)
+		result[prefix] = string(value)
+	}
+}
+
+func TestGoldenStale(t *testing.T) {
+	dirs, err := xfile.ListDir(noterrorDir, xfile.TypeFile, -1)
+	assert.Nil(t, err)
+
+	for _, v := range dirs {
+		name := ""
+		switch {
+		case strings.HasSuffix(v.Name, ".json"):
+			name = strings.TrimSuffix(v.Name, ".json")
+		case strings.HasSuffix(v.Name, ".pre"):
+			name = strings.TrimSuffix(v.Name, ".pre")
+		default:
+			continue
+		}
+
+		stale := !xfile.Exists(noterrorDir + "/" + name)
+		if !stale && strings.HasSuffix(v.Name, ".pre") {
+			domain := strings.Split(name, "_")[1]
+			extension := ""
+			if strings.Contains(name, ".") {
+				extension = domain[strings.LastIndex(domain, ".")+1:]
+			}
+			_, prepared := Prepare("", extension)
+			stale = !prepared
+		}
+
+		if !stale {
+			continue
+		}
+
+		if *update {
+			assert.Nil(t, os.Remove(noterrorDir+"/"+v.Name))
+		} else {
+			t.Errorf("golden file %s/%s has no source, run go test -update", noterrorDir, v.Name)
+		}
+	}
+}
+
+func TestDiffJSON(t *testing.T) {
+	diffs, err := diffJSON(`{"domain": {"status": ["ok"], "name": "google"}}`,
+		`{"domain": {"status": ["active", "linked"], "name": "google"}, "registrar": {"name": "MarkMonitor"}}`)
+	assert.Nil(t, err)
+	assert.Equal(t, diffs, []string{
+		`domain.status[0]: "ok" => "active"`,
+		`domain.status[1]: <none> => "linked"`,
+		`registrar.name: <none> => "MarkMonitor"`,
+	})
+
+	_, err = diffJSON(`{`, `{}`)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"strings"
+
+	"github.com/likexian/gokit/assert"
+	"github.com/likexian/gokit/xslice"
+	"golang.org/x/net/idna"
+)
+
+var (
+	// hostKeyRule is the key rule mapper for name server host object
+	hostKeyRule = map[string]string{
+		"server name":  "host_name",
+		"host name":    "host_name",
+		"hostname":     "host_name",
+		"name server":  "host_name",
+		"nameserver":   "host_name",
+		"nserver":      "host_name",
+		"host id":      "host_id",
+		"roid":         "host_id",
+		"ip address":   "host_address",
+		"ip addresses": "host_address",
+		"ipv4 address": "host_address",
+		"ipv6 address": "host_address",
+		"host status":  "host_status",
+		"status":       "host_status",
+	}
+)
+
+// ParseNameServer returns parsed name server host object whois info
+func ParseNameServer(text string) (nameServerInfo NameServerInfo, err error) { //nolint:cyclop
+	registrar := &Contact{}
+
+	text = strings.ReplaceAll(text, "\r", "")
+	for _, v := range strings.Split(text, "\n") {
+		v = strings.TrimSpace(v)
+		if len(v) < 5 || !strings.Contains(v, ":") {
+			continue
+		}
+
+		if assert.IsContains([]string{"-", "*", "%", ">", ";"}, v[:1]) {
+			continue
+		}
+
+		vs := strings.SplitN(v, ":", 2)
+		name := clearKeyName(vs[0])
+		value := strings.TrimSpace(vs[1])
+		if value == "" {
+			continue
+		}
+
+		// multiple host objects may be returned, only the first is parsed
+		if hostKeyRule[name] == "host_name" && nameServerInfo.Name != "" {
+			break
+		}
+
+		switch hostKeyRule[name] {
+		case "host_name":
+			nameServerInfo.Name = strings.ToLower(strings.Trim(value, "."))
+			nameServerInfo.Punycode, _ = idna.ToASCII(nameServerInfo.Name)
+		case "host_id":
+			nameServerInfo.ID = value
+		case "host_address":
+			nameServerInfo.IPAddresses = append(nameServerInfo.IPAddresses, strings.Fields(value)...)
+		case "host_status":
+			nameServerInfo.Status = append(nameServerInfo.Status, strings.Split(value, ",")...)
+		default:
+			switch searchKeyName(name) {
+			case "whois_server":
+				if nameServerInfo.WhoisServer == "" {
+					nameServerInfo.WhoisServer = value
+				}
+			case "created_date":
+				if nameServerInfo.CreatedDate == "" {
+					nameServerInfo.CreatedDate = value
+					if parsed, err := parseDateString(value); err == nil {
+						nameServerInfo.CreatedDateInTime = &parsed
+					}
+				}
+			case "updated_date":
+				if nameServerInfo.UpdatedDate == "" {
+					nameServerInfo.UpdatedDate = value
+					if parsed, err := parseDateString(value); err == nil {
+						nameServerInfo.UpdatedDateInTime = &parsed
+					}
+				}
+			case "referral_url":
+				registrar.ReferralURL = value
+			default:
+				if name == "registrar" {
+					name += " name"
+				}
+				if strings.HasPrefix(name, "registrar ") {
+					parseContact(registrar, "registrant "+strings.TrimPrefix(name, "registrar "), value)
+				}
+			}
+		}
+	}
+
+	if nameServerInfo.Name == "" {
+		err = getDomainErrorType(text)
+		return
+	}
+
+	nameServerInfo.IPAddresses = xslice.Unique(nameServerInfo.IPAddresses).([]string)
+	nameServerInfo.Status = xslice.Unique(fixDomainStatus(nameServerInfo.Status)).([]string)
+
+	if *registrar != (Contact{}) {
+		nameServerInfo.Registrar = registrar
+	}
+
+	return
+}
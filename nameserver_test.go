@@ -0,0 +1,88 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"testing"
+
+	"github.com/likexian/gokit/assert"
+)
+
+func TestParseNameServer(t *testing.T) {
+	// whois.verisign-grs.com "nameserver ns1.google.com"
+	data := `   Server Name: NS1.GOOGLE.COM
+   IP Address: 216.239.32.10
+   IP Address: 2001:4860:4802:32:0:0:0:a
+   Registrar: MarkMonitor Inc.
+   Registrar WHOIS Server: whois.markmonitor.com
+   Registrar URL: http://www.markmonitor.com
+>>> Last update of whois database: 2024-05-20T08:21:27Z <<<`
+
+	nameServerInfo, err := ParseNameServer(data)
+	assert.Nil(t, err)
+	assert.Equal(t, nameServerInfo.Name, "ns1.google.com")
+	assert.Equal(t, nameServerInfo.Punycode, "ns1.google.com")
+	assert.Equal(t, nameServerInfo.IPAddresses, []string{"216.239.32.10", "2001:4860:4802:32:0:0:0:a"})
+	assert.Equal(t, nameServerInfo.WhoisServer, "whois.markmonitor.com")
+	assert.Equal(t, nameServerInfo.Registrar.Name, "MarkMonitor Inc.")
+	assert.Equal(t, nameServerInfo.Registrar.ReferralURL, "http://www.markmonitor.com")
+
+	// host object with registry id, status and dates
+	data = `Host Name: NS1.EXAMPLE.ORG
+Host ID: H123456-LROR
+IP Address: 192.0.2.1 192.0.2.2
+Sponsoring Registrar: Example Registrar, Inc.
+Sponsoring Registrar IANA ID: 9999
+Host Status: ok (https://icann.org/epp#ok)
+Host Status: linked
+Creation Date: 2010-01-02T03:04:05Z
+Updated Date: 2020-01-02T03:04:05Z`
+
+	nameServerInfo, err = ParseNameServer(data)
+	assert.Nil(t, err)
+	assert.Equal(t, nameServerInfo.ID, "H123456-LROR")
+	assert.Equal(t, nameServerInfo.Name, "ns1.example.org")
+	assert.Equal(t, nameServerInfo.IPAddresses, []string{"192.0.2.1", "192.0.2.2"})
+	assert.Equal(t, nameServerInfo.Status, []string{"ok", "linked"})
+	assert.Equal(t, nameServerInfo.Registrar.Name, "Example Registrar, Inc.")
+	assert.Equal(t, nameServerInfo.Registrar.ID, "9999")
+	assert.Equal(t, nameServerInfo.CreatedDate, "2010-01-02T03:04:05Z")
+	assert.NotNil(t, nameServerInfo.CreatedDateInTime)
+	assert.Equal(t, nameServerInfo.UpdatedDate, "2020-01-02T03:04:05Z")
+	assert.NotNil(t, nameServerInfo.UpdatedDateInTime)
+
+	// multiple host objects, only the first is parsed
+	data = `   Server Name: NS1.EXAMPLE.COM
+   IP Address: 192.0.2.1
+   Registrar: Example Registrar, Inc.
+
+   Server Name: NS1.EXAMPLE.COM.EXAMPLE.NET
+   IP Address: 192.0.2.9
+   Registrar: Another Registrar, Inc.`
+
+	nameServerInfo, err = ParseNameServer(data)
+	assert.Nil(t, err)
+	assert.Equal(t, nameServerInfo.Name, "ns1.example.com")
+	assert.Equal(t, nameServerInfo.IPAddresses, []string{"192.0.2.1"})
+	assert.Equal(t, nameServerInfo.Registrar.Name, "Example Registrar, Inc.")
+
+	_, err = ParseNameServer(`No match for nameserver "NS1.LIKEXIAN-NO-MONEY.COM".`)
+	assert.Equal(t, err, ErrNotFoundDomain)
+}
@@ -20,10 +20,10 @@
 package whoisparser
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 
-	"github.com/likexian/gokit/assert"
 	"github.com/likexian/gokit/xslice"
 	"golang.org/x/net/idna"
 )
@@ -43,17 +43,37 @@ func License() string {
 	return "Licensed under the Apache License 2.0"
 }
 
-// Parse returns parsed whois info
-func Parse(text string) (whoisInfo WhoisInfo, err error) { //nolint:cyclop
+// parsePrepare is the preparer used by Parse, it is replaced in tests
+var parsePrepare = Prepare
+
+// Parse returns parsed whois info, an error wrapping ErrParserPanic
+// is returned if the whois data makes the parser panic, an error wrapping
+// ErrDomainDataTruncated is returned with the partial whois info if the
+// whois data looks truncated, ErrPendingDeleteDomain or ErrRedemptionDomain
+// is returned with the whois info if the domain status is in the state
+func Parse(text string) (whoisInfo WhoisInfo, err error) {
+	return parse(text, nil)
+}
+
+// parse returns parsed whois info, dates are parsed with the date cache of batch if any
+func parse(text string, dates *dateCache) (whoisInfo WhoisInfo, err error) { //nolint:cyclop
+	extension := ""
+	defer func() {
+		if r := recover(); r != nil {
+			whoisInfo, err = WhoisInfo{}, newParserPanicError(extension, r)
+		}
+	}()
+
 	name, extension := searchDomain(text)
 	if name == "" {
 		err = getDomainErrorType(text)
 		return
 	}
 
-	if extension != "" && isExtNotFoundDomain(text, extension) {
-		err = ErrNotFoundDomain
-		return
+	if extension != "" {
+		if err = getExtDomainErrorType(text, extension); err != nil {
+			return
+		}
 	}
 
 	domain := &Domain{}
@@ -66,43 +86,40 @@ func Parse(text string) (whoisInfo WhoisInfo, err error) { //nolint:cyclop
 	domain.Name, _ = idna.ToASCII(name)
 	domain.Extension, _ = idna.ToASCII(extension)
 
-	whoisText, _ := Prepare(text, domain.Extension)
-	whoisLines := strings.Split(whoisText, "\n")
-	for i := 0; i < len(whoisLines); i++ {
-		line := strings.TrimSpace(whoisLines[i])
-		if len(line) < 5 || !strings.Contains(line, ":") {
+	whoisText, _ := parsePrepare(text, domain.Extension)
+	scanner := lineScanner{text: whoisText}
+	for {
+		line, ok := scanner.next()
+		if !ok {
+			break
+		}
+
+		line = strings.TrimSpace(line)
+		if len(line) < 5 || strings.IndexByte(line, ':') == -1 {
 			continue
 		}
 
-		fChar := line[:1]
-		if assert.IsContains([]string{"-", "*", "%", ">", ";"}, fChar) {
+		if strings.IndexByte("-*%>;", line[0]) != -1 {
 			continue
 		}
 
-		if line[len(line)-1:] == ":" {
-			i++
-			for ; i < len(whoisLines); i++ {
-				thisLine := strings.TrimSpace(whoisLines[i])
-				if strings.Contains(thisLine, ":") {
-					break
-				}
-				line += thisLine + ","
-			}
-			line = strings.Trim(line, ",")
-			i--
+		if line[len(line)-1] == ':' {
+			line = scanner.joinValues(line)
 		}
 
-		lines := strings.SplitN(line, ":", 2)
-		name := strings.TrimSpace(lines[0])
-		value := strings.TrimSpace(lines[1])
-		value = strings.TrimSpace(strings.Trim(value, ":"))
+		name, value, _ := strings.Cut(line, ":")
+		name = strings.TrimSpace(name)
+		value = strings.TrimSpace(strings.Trim(strings.TrimSpace(value), ":"))
 
 		if value == "" {
 			continue
 		}
 
-		keyName := searchKeyName(name)
-		switch keyName {
+		key := clearKeyName(name)
+		if v, ok := extKeyNameRule[domain.Extension][key]; ok {
+			key = v
+		}
+		switch keyRule[key] {
 		case "domain_id":
 			domain.ID = value
 		case "domain_name":
@@ -128,28 +145,28 @@ func Parse(text string) (whoisInfo WhoisInfo, err error) { //nolint:cyclop
 		case "created_date":
 			if domain.CreatedDate == "" {
 				domain.CreatedDate = value
-				if parsed, err := parseDateString(value); err == nil {
+				if parsed, err := dates.parse(value); err == nil {
 					domain.CreatedDateInTime = &parsed
 				}
 			}
 		case "updated_date":
 			if domain.UpdatedDate == "" {
 				domain.UpdatedDate = value
-				if parsed, err := parseDateString(value); err == nil {
+				if parsed, err := dates.parse(value); err == nil {
 					domain.UpdatedDateInTime = &parsed
 				}
 			}
 		case "expired_date":
 			if domain.ExpirationDate == "" {
 				domain.ExpirationDate = value
-				if parsed, err := parseDateString(value); err == nil {
+				if parsed, err := dates.parse(value); err == nil {
 					domain.ExpirationDateInTime = &parsed
 				}
 			}
 		case "referral_url":
 			registrar.ReferralURL = value
 		default:
-			name = clearKeyName(name)
+			name = key
 			if !strings.Contains(name, " ") {
 				if name == "registrar" {
 					name += " name"
@@ -203,6 +220,18 @@ func Parse(text string) (whoisInfo WhoisInfo, err error) { //nolint:cyclop
 		whoisInfo.Billing = billing
 	}
 
+	if reason := searchTruncated(text, domain.Extension); reason != "" {
+		err = fmt.Errorf("%w: %s", ErrDomainDataTruncated, reason)
+	}
+
+	if rule, _ := searchStatusDomainError(text); rule.err != nil {
+		if err == nil {
+			err = rule.err
+		} else {
+			err = fmt.Errorf("%w: %w", rule.err, err)
+		}
+	}
+
 	return
 }
 
@@ -246,9 +275,13 @@ func parseContact(contact *Contact, name, value string) {
 	}
 }
 
-var searchDomainRx1 = regexp.MustCompile(`(?i)\[?domain\:?(\s*\_?name)?\]?[\s\.]*\:?` +
+// searchDomainLabel is the pattern of domain name label, e.g. "Domain Name:" or "[ドメイン名]"
+const searchDomainLabel = `(?i)\[?(?:domain\:?(\s*\_?name)?|ドメイン名|域名|доменное имя|имя домена|домен атауы|домен|` +
+	`dominio|domínio|domaine|domäne|domän|domeinnaam|domenenavn|domæne)\]?[\s\.]*[\:：]?`
+
+var searchDomainRx1 = regexp.MustCompile(searchDomainLabel +
 	`\s*([^\s\,\;\@\(\)]+)\.([^\s\,\;\(\)\.]{2,})`)
-var searchDomainRx2 = regexp.MustCompile(`(?i)\[?domain\:?(\s*\_?name)?\]?[\s\.]*\:?` +
+var searchDomainRx2 = regexp.MustCompile(searchDomainLabel +
 	`\s*([^\s\,\;\@\(\)\.]{2,})\n`)
 
 // searchDomain finds domain name and extension from whois information
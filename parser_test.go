@@ -20,14 +20,16 @@
 package whoisparser
 
 import (
+	"errors"
 	"fmt"
+	"path"
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/likexian/gokit/assert"
 	"github.com/likexian/gokit/xfile"
-	"github.com/likexian/gokit/xjson"
 	"golang.org/x/net/idna"
 )
 
@@ -66,11 +68,17 @@ func TestParseError(t *testing.T) {
 		ErrBlockedDomain:     "This name subscribes to the Uni EPS+ product",
 		ErrDomainDataInvalid: "connect to whois server failed: dial tcp 43: i/o timeout",
 		ErrDomainLimitExceed: "WHOIS LIMIT EXCEEDED - SEE WWW.PIR.ORG/WHOIS FOR DETAILS",
+
+		ErrPendingDeleteDomain: "This name is pending delete.",
+		ErrRedemptionDomain:    "This name is in redemption period.",
+		ErrQuarantineDomain:    "This name is in quarantine.",
+		ErrWithheldDomain:      "This name is withheld from registration.",
+		ErrUnavailableDomain:   "This name is not available for registration due to policy.",
 	}
 
 	for e, v := range tests {
 		_, err := Parse(v)
-		assert.Equal(t, err, e)
+		assert.True(t, errors.Is(err, e), v)
 	}
 
 	_, err := Parse(`Domain Name: likexian-no-money-registe.ai
@@ -78,48 +86,424 @@ func TestParseError(t *testing.T) {
 	assert.Equal(t, err, ErrNotFoundDomain)
 }
 
-func TestParse(t *testing.T) {
-	extensions := []string{}
-	domains := map[string][]string{}
+func TestParsePanic(t *testing.T) {
+	defer func(prepare func(string, string) (string, bool)) {
+		parsePrepare = prepare
+	}(parsePrepare)
 
-	dirs, err := xfile.ListDir(noterrorDir, xfile.TypeFile, -1)
+	parsePrepare = func(text, ext string) (string, bool) {
+		panic("preparer failed")
+	}
+
+	_, err := Parse("domain: example.ua\n")
+	assert.True(t, errors.Is(err, ErrParserPanic))
+	assert.Contains(t, err.Error(), `extension "ua"`)
+	assert.Contains(t, err.Error(), "preparer failed")
+	assert.Contains(t, err.Error(), "TestParsePanic.func2 (parser_test.go:")
+	assert.Contains(t, err.Error(), "<- Parse (parser.go:")
+}
+
+func TestParseNoColon(t *testing.T) {
+	// lines without colon separated value made the preparers panic
+	_, err := Parse("domain: example.ua\n% Registrar:\nfoo")
 	assert.Nil(t, err)
 
-	for _, v := range dirs {
-		if v.Name == "README.md" {
-			continue
-		}
+	_, err = Parse("domain: example.fr\n\nregistrar\n")
+	assert.Nil(t, err)
+}
 
-		domain := strings.Split(v.Name, "_")[1]
-		extension := ""
-		if strings.Contains(v.Name, ".") {
-			extension = domain[strings.LastIndex(domain, ".")+1:]
-		}
+func TestParseExtKeyName(t *testing.T) {
+	whoisInfo, err := Parse("Domain Name: example.mx\nEstado: Jalisco\nDocumento: 123\n")
+	assert.Nil(t, err)
+	assert.Equal(t, len(whoisInfo.Domain.Status), 0)
+	assert.True(t, whoisInfo.Registrant == nil)
 
-		if assert.IsContains([]string{"pre", "json"}, extension) {
-			continue
-		}
+	whoisInfo, err = Parse("Domain Name: example.br\nDocumento: 123\n")
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Registrant.ID, "123")
+
+	whoisInfo, err = Parse("Domenenavn.....: example.no\nRegistrant navn: Example AS\nOpprettet: 2020-01-01\n")
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "example.no")
+	assert.Equal(t, whoisInfo.Domain.CreatedDate, "2020-01-01")
+	assert.Equal(t, whoisInfo.Registrant.Name, "Example AS")
+}
+
+func TestParseCyrillicLabels(t *testing.T) {
+	whoisInfo, err := Parse(`Домен:                САЙТ.РФ
+Серверы DNS:          ns1.reg.ru.
+Состояние:            REGISTERED, DELEGATED, VERIFIED
+Администратор:        ООО "Сайт"
+Регистратор:          REGRU-RF
+Администратор домена: https://www.reg.ru/whois/admin_contact
+Дата регистрации:     10 мая 2010 г.
+Оплачен до:           10 мая 2026 г.
+Последнее обновление: 2025-10-19T10:56:30Z
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "сайт.рф")
+	assert.Equal(t, whoisInfo.Domain.Punycode, "xn--80aswg.xn--p1ai")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"REGISTERED", "DELEGATED", "VERIFIED"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.reg.ru"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDateInTime.Format("2006-01-02"), "2010-05-10")
+	assert.Equal(t, whoisInfo.Domain.ExpirationDateInTime.Format("2006-01-02"), "2026-05-10")
+	assert.NotNil(t, whoisInfo.Domain.UpdatedDateInTime)
+	assert.Equal(t, whoisInfo.Registrar.Name, "REGRU-RF")
+	assert.Equal(t, whoisInfo.Registrant.Organization, `ООО "Сайт"`)
+
+	whoisInfo, err = Parse(`домен:            приклад.укр
+сервер імен:      ns1.hostmaster.ua
+статус:           ok
+створено:         15 березня 2013 р.
+змінено:          2 лютого 2025 р.
+закінчується:     15 березня 2026 р.
+
+% Реєстратор:
+% ==========
+реєстратор:       ua.hostmaster
+організація:      ТОВ "Хостмайстер"
+країна:           UA
+
+% Реєстрант:
+% ===========
+особа:            Іван Петренко
+організація:      ТОВ "Приклад"
+електронна пошта: admin@example.com.ua
+адреса:           вул. Хрещатик, 1
+поштовий індекс:  01001
+телефон:          +380.441234567
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Punycode, "xn--80aikifvh.xn--j1amh")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"ok"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.hostmaster.ua"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDateInTime.Format("2006-01-02"), "2013-03-15")
+	assert.Equal(t, whoisInfo.Domain.UpdatedDateInTime.Format("2006-01-02"), "2025-02-02")
+	assert.Equal(t, whoisInfo.Domain.ExpirationDateInTime.Format("2006-01-02"), "2026-03-15")
+	assert.Equal(t, whoisInfo.Registrar.Name, "ua.hostmaster")
+	assert.Equal(t, whoisInfo.Registrar.Organization, `ТОВ "Хостмайстер"`)
+	assert.Equal(t, whoisInfo.Registrar.Country, "UA")
+	assert.Equal(t, whoisInfo.Registrant.Name, "Іван Петренко")
+	assert.Equal(t, whoisInfo.Registrant.Organization, `ТОВ "Приклад"`)
+	assert.Equal(t, whoisInfo.Registrant.Email, "admin@example.com.ua")
+	assert.Equal(t, whoisInfo.Registrant.Street, "вул. Хрещатик, 1")
+	assert.Equal(t, whoisInfo.Registrant.PostalCode, "01001")
+	assert.Equal(t, whoisInfo.Registrant.Phone, "+380.441234567")
+
+	whoisInfo, err = Parse(`Доменное имя...........: nic.kz
+
+Организация, использующая доменное имя
+Имя....................: Казахстанский центр сетевой информации
+Адрес..................: ул. Сатпаева, 29/3
+Город..................: Алматы
+Страна.................: KZ
+
+Административный контакт
+NIC Handle.............: C000000000001-KZ
+Имя....................: Администратор DNS
+Электронная почта......: hostmaster@nic.kz
+
+Серверы имен в порядке очереди
+
+Первичный сервер.......: ns.nic.kz
+Первичный IP-адрес.....: 195.12.113.40
+
+
+Домен создан...........: 23 апреля 2003 10:17:00
+Последнее изменение....: 12 марта 2024 08:15:30
+Статус домена..........: ok - Нормальное состояние.
+
+Текущий регистратор....: KAZNIC
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "nic.kz")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"ok"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns.nic.kz"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDateInTime.Format(time.DateTime), "2003-04-23 10:17:00")
+	assert.Equal(t, whoisInfo.Domain.UpdatedDateInTime.Format(time.DateTime), "2024-03-12 08:15:30")
+	assert.Equal(t, whoisInfo.Registrar.Name, "KAZNIC")
+	assert.Equal(t, whoisInfo.Registrant.Name, "Казахстанский центр сетевой информации")
+	assert.Equal(t, whoisInfo.Registrant.Street, "ул. Сатпаева, 29/3")
+	assert.Equal(t, whoisInfo.Registrant.City, "Алматы")
+	assert.Equal(t, whoisInfo.Registrant.Country, "KZ")
+	assert.Equal(t, whoisInfo.Administrative.ID, "C000000000001-KZ")
+	assert.Equal(t, whoisInfo.Administrative.Name, "Администратор DNS")
+	assert.Equal(t, whoisInfo.Administrative.Email, "hostmaster@nic.kz")
+
+	whoisInfo, err = Parse(`Имя домена: hoster.by
+Регистратор: ООО "Надежные программы"
+Организация: ООО "Надежные программы"
+Страна: BY
+Адрес: 220004, г. Минск, ул. Амураторская, 4
+Телефон: +375.173881212
+Сервер имен: ns1.hoster.by
+Дата обновления: 2025-02-10
+Дата создания: 2004-03-01
+Дата окончания: 2026-03-01
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "hoster.by")
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.hoster.by"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDate, "2004-03-01")
+	assert.Equal(t, whoisInfo.Domain.UpdatedDate, "2025-02-10")
+	assert.Equal(t, whoisInfo.Domain.ExpirationDate, "2026-03-01")
+	assert.Equal(t, whoisInfo.Registrar.Name, `ООО "Надежные программы"`)
+	assert.Equal(t, whoisInfo.Registrant.Organization, `ООО "Надежные программы"`)
+	assert.Equal(t, whoisInfo.Registrant.Country, "BY")
+	assert.Equal(t, whoisInfo.Registrant.Street, "220004, г. Минск, ул. Амураторская, 4")
+	assert.Equal(t, whoisInfo.Registrant.Phone, "+375.173881212")
+}
+
+func TestParseLatinLabels(t *testing.T) {
+	whoisInfo, err := Parse(`Nombre de Dominio:       unam.mx
+
+Fecha de Creación:       1989-02-14
+Fecha de Vencimiento:    2026-02-13
+Última Actualización:    2025-01-15
+Registrador:             Universidad Nacional Autónoma de México
+URL del Registrador:     http://www.unam.mx/
+
+Titular:                 Universidad Nacional Autónoma de México
+Ciudad del Titular:      Ciudad de México
+País del Titular:        México
+
+Contacto Administrativo: Dirección General de Cómputo
+Contacto Técnico:        Dirección General de Cómputo
+
+Servidores de Nombres:
+   ns1.unam.mx
+   ns2.unam.mx
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "unam.mx")
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.unam.mx", "ns2.unam.mx"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDate, "1989-02-14")
+	assert.Equal(t, whoisInfo.Domain.UpdatedDate, "2025-01-15")
+	assert.Equal(t, whoisInfo.Domain.ExpirationDate, "2026-02-13")
+	assert.Equal(t, whoisInfo.Registrar.Name, "Universidad Nacional Autónoma de México")
+	assert.Equal(t, whoisInfo.Registrar.ReferralURL, "http://www.unam.mx/")
+	assert.Equal(t, whoisInfo.Registrant.Name, "Universidad Nacional Autónoma de México")
+	assert.Equal(t, whoisInfo.Registrant.City, "Ciudad de México")
+	assert.Equal(t, whoisInfo.Registrant.Country, "México")
+	assert.Equal(t, whoisInfo.Administrative.Name, "Dirección General de Cómputo")
+	assert.Equal(t, whoisInfo.Technical.Name, "Dirección General de Cómputo")
+
+	whoisInfo, err = Parse(`Nombre de dominio: uchile.cl
+Titular: Universidad de Chile
+Agente Registrador: NIC Chile
+URL del registrador: https://www.nic.cl
+Fecha de creación: 1997-10-22 17:48:23 CLST
+Fecha de expiración: 2026-11-20 14:48:02 CLST
+Servidor de nombre: ns1.uchile.cl
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "uchile.cl")
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.uchile.cl"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDateInTime.Format(time.DateTime), "1997-10-22 17:48:23")
+	assert.Equal(t, whoisInfo.Domain.ExpirationDateInTime.Format(time.DateTime), "2026-11-20 14:48:02")
+	assert.Equal(t, whoisInfo.Registrar.Name, "NIC Chile")
+	assert.Equal(t, whoisInfo.Registrar.ReferralURL, "https://www.nic.cl")
+	assert.Equal(t, whoisInfo.Registrant.Name, "Universidad de Chile")
+
+	whoisInfo, err = Parse(`domínio:     registro.br
+titular:     Núcleo de Informação e Coordenação do Ponto BR - NIC.BR
+documento:   005.506.560/0001-36
+servidor dns: a.dns.br
+criado:      1999-03-24
+alterado:    2025-01-20
+expiração:   2026-03-24
+status:      published
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "registro.br")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"published"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"a.dns.br"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDate, "1999-03-24")
+	assert.Equal(t, whoisInfo.Domain.UpdatedDate, "2025-01-20")
+	assert.Equal(t, whoisInfo.Domain.ExpirationDate, "2026-03-24")
+	assert.Equal(t, whoisInfo.Registrant.ID, "005.506.560/0001-36")
+	assert.Equal(t, whoisInfo.Registrant.Name, "Núcleo de Informação e Coordenação do Ponto BR - NIC.BR")
+
+	whoisInfo, err = Parse(`Nom de domaine: cira.ca
+ID du domaine: D345370-CIRA
+Serveur WHOIS: whois.cira.ca
+URL du bureau d'enregistrement: www.cira.ca
+Date de mise à jour: 2025-03-02T18:22:14Z
+Date de création: 2000-10-23T18:42:43Z
+Date d'expiration: 2026-10-23T04:00:00Z
+Bureau d'enregistrement: Autorité canadienne pour les enregistrements Internet
+Statut du domaine: clientTransferProhibited https://icann.org/epp#clientTransferProhibited
+Titulaire: Autorité canadienne pour les enregistrements Internet
+Courriel du titulaire: hostmaster@cira.ca
+Contact administratif: Service des noms de domaine
+Contact technique: Service des noms de domaine
+Serveur de noms: any.ca-servers.ca
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "cira.ca")
+	assert.Equal(t, whoisInfo.Domain.ID, "D345370-CIRA")
+	assert.Equal(t, whoisInfo.Domain.WhoisServer, "whois.cira.ca")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"clientTransferProhibited"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"any.ca-servers.ca"})
+	assert.NotNil(t, whoisInfo.Domain.CreatedDateInTime)
+	assert.NotNil(t, whoisInfo.Domain.UpdatedDateInTime)
+	assert.NotNil(t, whoisInfo.Domain.ExpirationDateInTime)
+	assert.Equal(t, whoisInfo.Registrar.Name, "Autorité canadienne pour les enregistrements Internet")
+	assert.Equal(t, whoisInfo.Registrar.ReferralURL, "www.cira.ca")
+	assert.Equal(t, whoisInfo.Registrant.Name, "Autorité canadienne pour les enregistrements Internet")
+	assert.Equal(t, whoisInfo.Registrant.Email, "hostmaster@cira.ca")
+	assert.Equal(t, whoisInfo.Administrative.Name, "Service des noms de domaine")
+	assert.Equal(t, whoisInfo.Technical.Name, "Service des noms de domaine")
+}
+
+func TestParseGermanicLabels(t *testing.T) {
+	whoisInfo, err := Parse(`Domäne: denic.de
+Nameserver: ns1.denic.de
+Status: connect
+Zuletzt geändert: 2022-11-23T10:14:52+01:00
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "denic.de")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"connect"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.denic.de"})
+	assert.Equal(t, whoisInfo.Domain.UpdatedDate, "2022-11-23T10:14:52+01:00")
+
+	whoisInfo, err = Parse(`domäne:              nic.at
+inhaber:             NAG1234567-NICAT
+nserver:             ns1.univie.ac.at
+zuletzt geändert:    20230614 11:02:37
+quelle:              AT-DOM
+
+personenname:     Domain Administration
+organisation:     nic.at GmbH
+straße:           Jakob-Haringer-Strasse 8/V
+postleitzahl:     5020
+ort:              Salzburg
+land:             Austria
+telefon:          +43662466690
+e-mail:           service@nic.at
+nic-hdl:          NAG1234567-NICAT
+quelle:           AT-DOM
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "nic.at")
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.univie.ac.at"})
+	assert.Equal(t, whoisInfo.Domain.UpdatedDateInTime.Format(time.DateTime), "2023-06-14 11:02:37")
+	assert.Equal(t, whoisInfo.Registrant.ID, "NAG1234567-NICAT")
+	assert.Equal(t, whoisInfo.Registrant.Name, "Domain Administration")
+	assert.Equal(t, whoisInfo.Registrant.Organization, "nic.at GmbH")
+	assert.Equal(t, whoisInfo.Registrant.Street, "Jakob-Haringer-Strasse 8/V, 5020, Salzburg, Austria")
+	assert.Equal(t, whoisInfo.Registrant.Phone, "+43662466690")
+	assert.Equal(t, whoisInfo.Registrant.Email, "service@nic.at")
+
+	whoisInfo, err = Parse("Domainname\tnic.ch\n\nRegistrar\tSWITCH Foundation\nWerdstrasse 2\n" +
+		"Phone +41 44 268 15 15\ninfo@switch.ch\n\nNameserver\nns1.switch.ch\n\nErstregistrierungsdatum\t19.02.1992\n")
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "nic.ch")
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.switch.ch"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDateInTime.Format("2006-01-02"), "1992-02-19")
+	assert.Equal(t, whoisInfo.Registrar.Name, "SWITCH Foundation")
+	assert.Equal(t, whoisInfo.Registrar.Street, "Werdstrasse 2")
+	assert.Equal(t, whoisInfo.Registrar.Phone, "+41 44 268 15 15")
+	assert.Equal(t, whoisInfo.Registrar.Email, "info@switch.ch")
+
+	whoisInfo, err = Parse(`Domeinnaam: sidn.nl
+Status:     active
+
+Registrar:
+   Stichting Internet Domeinregistratie Nederland
+   Meander 501
+
+Wederverkoper:
+   Example Reseller B.V.
+
+Domeinnaamservers:
+   ns1.sidn.nl
+
+Registratiedatum: 1999-05-27
+Laatst gewijzigd: 2023-02-14
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "sidn.nl")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"active"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.sidn.nl"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDate, "1999-05-27")
+	assert.Equal(t, whoisInfo.Domain.UpdatedDate, "2023-02-14")
+	assert.Equal(t, whoisInfo.Registrar.Name, "Stichting Internet Domeinregistratie Nederland")
+	assert.Equal(t, whoisInfo.Registrar.Street, "Meander 501")
+
+	whoisInfo, err = Parse(`Domæne:               dk-hostmaster.dk
+DNS:                  dk-hostmaster.dk
+Registreret:          1999-05-19
+Udløber:              2024-06-30
+Status:               Active
+
+Registrant
+Handle:               ***N/A***
+Navn:                 Punktum dk A/S
+Adresse:              Ørestads Boulevard 108, 11.
+Postnummer:           2300
+By:                   København S
+Land:                 DK
+
+Nameservers
+Værtsnavn:            p.nic.dk
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "dk-hostmaster.dk")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"Active"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"p.nic.dk"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDate, "1999-05-19")
+	assert.Equal(t, whoisInfo.Domain.ExpirationDate, "2024-06-30")
+	assert.Equal(t, whoisInfo.Registrant.Name, "Punktum dk A/S")
+	assert.Equal(t, whoisInfo.Registrant.Street, "Ørestads Boulevard 108, 11.")
+	assert.Equal(t, whoisInfo.Registrant.PostalCode, "2300")
+	assert.Equal(t, whoisInfo.Registrant.City, "København S")
+	assert.Equal(t, whoisInfo.Registrant.Country, "DK")
+
+	whoisInfo, err = Parse(`tillstånd:        active
+domän:            iis.se
+innehavare:       iis1001-00001
+skapad:           1997-12-11
+ändrad:           2023-01-13
+utgår:            2024-12-11
+namnserver:       a.ns.se
+status:           ok
+registrator:      Internetstiftelsen i Sverige
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "iis.se")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"active", "ok"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"a.ns.se"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDate, "1997-12-11")
+	assert.Equal(t, whoisInfo.Domain.UpdatedDate, "2023-01-13")
+	assert.Equal(t, whoisInfo.Domain.ExpirationDate, "2024-12-11")
+	assert.Equal(t, whoisInfo.Registrar.Name, "Internetstiftelsen i Sverige")
+	assert.Equal(t, whoisInfo.Registrant.Organization, "iis1001-00001")
+}
+
+func TestParse(t *testing.T) {
+	extensions := []string{}
+	domains := map[string][]string{}
+
+	for _, v := range listCorpus(t, noterrorDir) {
+		domain, extension := v.domain, v.extension
 
-		whoisRaw, err := xfile.ReadText(noterrorDir + "/" + v.Name)
+		whoisRaw, err := xfile.ReadText(noterrorDir + "/" + v.name)
 		assert.Nil(t, err)
 
 		whoisInfo, err := Parse(whoisRaw)
-		assert.Nil(t, err, v.Name)
+		assert.Nil(t, err, v.name)
 
 		assert.Equal(t, whoisInfo.Domain.Punycode, domain)
 		assert.Equal(t, whoisInfo.Domain.Extension, extension)
 
-		if !assert.IsContains([]string{"", "at", "aq", "br", "ch", "de", "edu", "eu", "fr", "gg", "gov", "hk",
-			"hm", "int", "it", "jp", "kr", "kz", "mo", "nl", "nz", "pl", "pm", "re", "ro", "ru", "su", "tf", "ee",
-			"tk", "travel", "tv", "tw", "uk", "wf", "yt", "ir", "fi", "rs", "dk", "by", "ua",
-			"xn--mgba3a4f16a", "xn--p1ai", "se", "sk", "nu", "hu"}, extension) {
-			assert.NotZero(t, whoisInfo.Domain.ID)
+		capability := Capabilities(extension)
+		if extension != "" {
+			capability = Capabilities(domain)
 		}
 
-		if !assert.IsContains([]string{"at", "ch", "edu", "eu", "int", "kr", "mo", "tw", "ir", "pl", "tk", "by",
-			"xn--mgba3a4f16a", "hu"}, extension) {
-			assert.NotZero(t, whoisInfo.Domain.Status)
-		}
+		assert.Equal(t, capability.Missing(whoisInfo), []Field{}, v.name)
 
 		if assert.IsContains([]string{"aftermarket.pl", "nazwa.pl", "git.nl", "git.wf", "by",
 			"switch.ch", "git.xyz", "emilstahl.dk", "folketinget.dk", "nic.nu", "xn--fl-fka.se"}, domain) {
@@ -128,66 +512,19 @@ func TestParse(t *testing.T) {
 			assert.False(t, whoisInfo.Domain.DNSSec)
 		}
 
-		if !assert.IsContains([]string{"aero", "ai", "at", "aq", "asia", "berlin", "biz", "br", "ch", "cn",
-			"co", "cymru", "de", "edu", "eu", "fr", "gg", "gov", "hk", "hm", "in", "int", "it", "jp", "kr",
-			"la", "london", "me", "mo", "museum", "name", "nl", "nz", "pm", "re", "ro", "ru", "sh", "sk",
-			"kz", "su", "tel", "ee", "tf", "tk", "travel", "tw", "uk", "us", "wales", "wf", "xxx",
-			"yt", "ir", "fi", "rs", "dk", "by", "ua", "xn--mgba3a4f16a", "xn--fiqs8s", "xn--p1ai",
-			"se", "nu", "hu"}, extension) {
-			assert.NotZero(t, whoisInfo.Domain.WhoisServer)
+		if capability.Expects(FieldDomainCreatedDate) {
+			assert.NotNil(t, whoisInfo.Domain.CreatedDateInTime, v.name)
 		}
 
-		if !assert.IsContains([]string{"gov", "name", "tw", "hu"}, extension) {
-			assert.NotZero(t, whoisInfo.Domain.NameServers)
+		if capability.Expects(FieldDomainUpdatedDate) {
+			assert.NotNil(t, whoisInfo.Domain.UpdatedDateInTime, v.name)
 		}
 
-		if !assert.IsContains([]string{"aq", "ai", "at", "au", "de", "eu", "gov", "hm", "name", "nl", "nz", "ir", "tk",
-			"xn--mgba3a4f16a"}, extension) &&
-			!strings.Contains(domain, "ac.jp") &&
-			!strings.Contains(domain, "co.jp") &&
-			!strings.Contains(domain, "go.jp") &&
-			!strings.Contains(domain, "ne.jp") {
-			assert.NotZero(t, whoisInfo.Domain.CreatedDate)
-			assert.NotNil(t, whoisInfo.Domain.CreatedDateInTime)
+		if capability.Expects(FieldDomainExpirationDate) {
+			assert.NotNil(t, whoisInfo.Domain.ExpirationDateInTime, v.name)
 		}
 
-		if !assert.IsContains([]string{"aq", "ai", "at", "ch", "cn", "eu", "gg", "gov", "hk", "hm", "mo",
-			"name", "nl", "ro", "ru", "su", "tk", "tw", "dk", "xn--fiqs8s", "xn--p1ai", "hu"}, extension) {
-			assert.NotZero(t, whoisInfo.Domain.UpdatedDate)
-			assert.NotNil(t, whoisInfo.Domain.UpdatedDateInTime)
-		}
-
-		if !assert.IsContains([]string{"", "ai", "at", "aq", "au", "br", "ch", "de", "eu", "gg", "gov", "ee",
-			"hm", "int", "name", "nl", "nz", "tk", "kz", "hu"}, extension) &&
-			!strings.Contains(domain, "ac.jp") &&
-			!strings.Contains(domain, "co.jp") &&
-			!strings.Contains(domain, "go.jp") &&
-			!strings.Contains(domain, "ne.jp") {
-			assert.NotZero(t, whoisInfo.Domain.ExpirationDate)
-			assert.NotNil(t, whoisInfo.Domain.ExpirationDateInTime)
-		}
-
-		if !assert.IsContains([]string{"", "ai", "at", "aq", "au", "br", "ca", "ch", "cn", "cx", "de",
-			"edu", "eu", "fr", "gg", "gov", "gs", "hk", "hm", "int", "it", "jp", "kr", "kz", "la", "mo", "nl",
-			"nz", "pl", "pm", "re", "ro", "ru", "su", "sk", "tf", "tk", "tw", "uk", "wf", "yt", "ir", "fi", "rs",
-			"ee", "dk", "by", "ua", "xn--mgba3a4f16a", "xn--fiqs8s", "xn--p1ai", "se", "nu", "hu"}, extension) {
-			assert.NotZero(t, whoisInfo.Registrar.ID)
-		}
-
-		if !assert.IsContains([]string{"", "at", "aq", "br", "de",
-			"edu", "gov", "hm", "int", "jp", "mo", "tk", "ir", "dk", "xn--mgba3a4f16a", "hu"}, extension) {
-			assert.NotZero(t, whoisInfo.Registrar.Name)
-		}
-
-		if !assert.IsContains([]string{"", "aero", "ai", "at", "aq", "asia", "au", "br", "ch", "cn", "de",
-			"edu", "gov", "hk", "hm", "int", "jp", "kr", "kz", "la", "london", "love", "mo",
-			"museum", "name", "nl", "nz", "pl", "ru", "sk", "su", "tk", "top", "ir", "fi", "rs", "dk", "by", "ua",
-			"xn--mgba3a4f16a", "xn--fiqs8s", "xn--p1ai", "se", "nu", "hu"}, extension) {
-			assert.NotZero(t, whoisInfo.Registrar.ReferralURL)
-		}
-
-		err = xjson.Dump(noterrorDir+"/"+v.Name+".json", whoisInfo)
-		assert.Nil(t, err)
+		assertGoldenJSON(t, noterrorDir+"/"+v.name+".json", whoisInfo)
 
 		extension, _ = idna.ToUnicode(extension)
 		if !assert.IsContains(extensions, extension) {
@@ -217,8 +554,7 @@ func TestParse(t *testing.T) {
 		}
 	}
 
-	err = xfile.WriteText(noterrorDir+"/README.md", strings.TrimSpace(verified))
-	assert.Nil(t, err)
+	assertGoldenText(t, noterrorDir+"/README.md", strings.TrimSpace(verified))
 }
 
 func TestAssearchDomain(t *testing.T) {
@@ -245,3 +581,32 @@ func TestAssearchDomain(t *testing.T) {
 		assert.Equal(t, extension, v.extension)
 	}
 }
+
+func BenchmarkParse(b *testing.B) {
+	corpus := readCorpus(b, noterrorDir)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, v := range corpus {
+			_, _ = Parse(v)
+		}
+	}
+}
+
+func BenchmarkParseExtension(b *testing.B) {
+	for _, dir := range []string{noterrorDir, notfoundDir} {
+		groups := groupCorpus(readCorpus(b, dir))
+		for _, extension := range sortedKeys(groups) {
+			b.Run(path.Base(dir)+"/"+benchName(extension), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					for _, v := range groups[extension] {
+						_, _ = Parse(v)
+					}
+				}
+			})
+		}
+	}
+}
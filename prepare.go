@@ -90,7 +90,7 @@ func Prepare(text, ext string) (string, bool) { //nolint:cyclop
 		return prepareDK(text), true
 	case "by":
 		return prepareBY(text), true
-	case "ua":
+	case "ua", "xn--j1amh":
 		return prepareUA(text), true
 	case "at":
 		return prepareAT(text), true
@@ -106,7 +106,7 @@ func Prepare(text, ext string) (string, bool) { //nolint:cyclop
 // prepareTLD do prepare the tld domain
 func prepareTLD(text string) string {
 	token := ""
-	result := ""
+	var result strings.Builder
 
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
@@ -129,10 +129,11 @@ func prepareTLD(text string) string {
 				}
 			}
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareEDU do prepare the .edu domain
@@ -163,7 +164,7 @@ func prepareEDU(text string) string {
 	token := ""
 	index := 0
 
-	result := ""
+	var result strings.Builder
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
 		if v == "" {
@@ -177,8 +178,9 @@ func prepareEDU(text string) string {
 			token = v
 		} else {
 			if token == "" {
-				result += "\n" + v
-			} else {
+				result.WriteByte('\n')
+				result.WriteString(v)
+			} else if index < len(tokens[token]) {
 				// address ending now jump to phone
 				if tokens[token][index] == "Address" && strings.HasPrefix(v, "+") {
 					found := xslice.Index(tokens[token], "Phone")
@@ -186,7 +188,7 @@ func prepareEDU(text string) string {
 						index = found
 					}
 				}
-				result += fmt.Sprintf("\n%s %s: %s", token[:len(token)-1], tokens[token][index], v)
+				fmt.Fprintf(&result, "\n%s %s: %s", token[:len(token)-1], tokens[token][index], v)
 				if tokens[token][index] != "Address" {
 					index++
 				}
@@ -194,13 +196,13 @@ func prepareEDU(text string) string {
 		}
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareINT do prepare the .int domain
 func prepareINT(text string) string {
 	token := ""
-	result := ""
+	var result strings.Builder
 
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
@@ -223,18 +225,44 @@ func prepareINT(text string) string {
 				}
 			}
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
+}
+
+// prepareKZLabels is the english labels of russian labels in .kz whois
+var prepareKZLabels = map[string]string{
+	"доменное имя": "Domain Name",
+	"имя":          "Name",
+	"название организации": "Organization Name",
+	"адрес":               "Street Address",
+	"город":               "City",
+	"область":             "State",
+	"почтовый индекс":     "Postal Code",
+	"страна":              "Country",
+	"телефон":             "Phone Number",
+	"факс":                "Fax Number",
+	"электронная почта":   "Email Address",
+	"первичный сервер":    "Primary server",
+	"вторичный сервер":    "Secondary server",
+	"домен создан":        "Domain created",
+	"последнее изменение": "Last modified",
+	"статус домена":       "Domain status",
+	"текущий регистратор": "Current Registar",
 }
 
 // prepareKZ do prepare the .kz domain
 func prepareKZ(text string) string {
 
+	text = prepareLabels(text, prepareKZLabels)
+
 	groupTokens := map[string]string{
-		"Organization Using Domain Name": "Registrant ",
-		"Administrative Contact/Agent":   "Administrative ",
+		"Organization Using Domain Name":         "Registrant ",
+		"Administrative Contact/Agent":           "Administrative ",
+		"Организация, использующая доменное имя": "Registrant ",
+		"Административный контакт":               "Administrative ",
 	}
 
 	topTokens := map[string]string{
@@ -249,7 +277,7 @@ func prepareKZ(text string) string {
 
 	groupToken := ""
 	topToken := ""
-	result := ""
+	var result strings.Builder
 
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
@@ -291,14 +319,109 @@ func prepareKZ(text string) string {
 
 		v = fmt.Sprintf("%s: %s", key, value)
 
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
+	}
+
+	return result.String()
+}
+
+// prepareZHLabels is the english labels of chinese contact labels in simplified
+// and traditional chinese, the domain labels are mapped in keyRule directly
+var prepareZHLabels = map[string]string{
+	"持有人":     "Registrant",
+	"域名持有人":   "Registrant",
+	"注册人":     "Registrant",
+	"註冊人":     "Registrant",
+	"持有人id":   "Registrant ID",
+	"注册人id":   "Registrant ID",
+	"註冊人id":   "Registrant ID",
+	"持有人邮箱":   "Registrant Contact Email",
+	"注册人邮箱":   "Registrant Contact Email",
+	"註冊人電郵":   "Registrant Contact Email",
+	"联系人邮箱":   "Registrant Contact Email",
+	"聯絡人電郵":   "Registrant Contact Email",
+	"注册商":     "Registrar Name",
+	"註冊商":     "Registrar Name",
+	"注册商名称":   "Registrar Name",
+	"註冊商名稱":   "Registrar Name",
+	"注册服务机构":  "Registrar Name",
+	"註冊服務機構":  "Registrar Name",
+	"註冊服務商":   "Registrar Name",
+	"註冊服務商名稱": "Registrar Name",
+	"机构":      "Organization",
+	"機構":      "Organization",
+	"组织":      "Organization",
+	"組織":      "Organization",
+	"公司名称":    "Organization",
+	"公司名稱":    "Organization",
+	"姓名":      "Name",
+	"地址":      "Address",
+	"城市":      "City",
+	"省份":      "State/Province",
+	"邮编":      "Postal Code",
+	"郵編":      "Postal Code",
+	"邮政编码":    "Postal Code",
+	"郵政編碼":    "Postal Code",
+	"郵遞區號":    "Postal Code",
+	"国家":      "Country",
+	"國家":      "Country",
+	"国家/地区":   "Country",
+	"國家/地區":   "Country",
+	"电话":      "Phone",
+	"電話":      "Phone",
+	"传真":      "Fax",
+	"傳真":      "Fax",
+	"电子邮件":    "Email",
+	"電子郵件":    "Email",
+	"邮箱":      "Email",
+	"電郵":      "Email",
+}
+
+// prepareZH replaces the chinese labels with english ones, labels are the labels
+// specific to the extension such as section names, which take precedence
+func prepareZH(text string, labels map[string]string) string {
+	return prepareLabels(text, labels, prepareZHLabels)
+}
+
+// prepareLabels replaces the labels of text with english ones, and the full-width colon
+// after label, labels are looked up in lowercase with trailing dots trimmed, in order
+func prepareLabels(text string, labels ...map[string]string) string {
+	var result strings.Builder
+
+	for k, v := range strings.Split(text, "\n") {
+		if k > 0 {
+			result.WriteByte('\n')
+		}
+		if pos := strings.Index(v, "："); pos != -1 {
+			if colon := strings.IndexByte(v, ':'); colon == -1 || colon > pos {
+				v = v[:pos] + ":" + v[pos+len("："):]
+			}
+		}
+		if label, value, ok := strings.Cut(v, ":"); ok {
+			label = strings.ToLower(strings.TrimRight(strings.TrimSpace(label), ". "))
+			for _, m := range labels {
+				if english, ok := m[label]; ok {
+					v = english + ":" + value
+					break
+				}
+			}
+		}
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareMO do prepare the .mo domain
 func prepareMO(text string) string {
+	text = prepareZH(text, map[string]string{
+		"註冊人":   "Registrant",
+		"管理聯絡人": "Admin Contact(s)",
+		"帳單聯絡人": "Billing Contact(s)",
+		"技術聯絡人": "Technical Contact(s)",
+	})
+
 	tokens := map[string]string{
 		"Registrant:":           "Registrant",
 		"Admin Contact(s):":     "Admin",
@@ -307,7 +430,7 @@ func prepareMO(text string) string {
 	}
 
 	token := ""
-	result := ""
+	var result strings.Builder
 
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
@@ -330,16 +453,32 @@ func prepareMO(text string) string {
 				v = fmt.Sprintf("%s %s", token, v)
 			}
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 var prepareHKEmailRx = regexp.MustCompile(`Email\:\s+([^\s]+)(\s+Hotline\:(.*))?`)
 
 // prepareHK do prepare the .hk domain
 func prepareHK(text string) string {
+	text = prepareZH(text, map[string]string{
+		"註冊服務商聯絡資料": "Registrar Contact Information",
+		"註冊人聯絡資料":   "Registrant Contact Information",
+		"管理聯絡人資料":   "Administrative Contact Information",
+		"技術聯絡人資料":   "Technical Contact Information",
+		"域名伺服器資料":   "Name Servers Information",
+		"狀態資料":      "Status Information",
+		"公司英文名稱":    "Company English Name",
+		"公司中文名稱":    "Company Chinese name",
+		"名字":        "Given name",
+		"姓氏":        "Family name",
+		"域名註冊日期":    "Domain Name Commencement Date",
+		"屆滿日期":      "Expiry Date",
+	})
+
 	tokens := map[string]string{
 		"Registrant Contact Information:":     "Registrant",
 		"Administrative Contact Information:": "Admin",
@@ -356,7 +495,7 @@ func prepareHK(text string) string {
 	addressToken := false
 	text = strings.ReplaceAll(text, "\n\n", "\n")
 
-	result := ""
+	var result strings.Builder
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
 		if v == "" {
@@ -388,13 +527,15 @@ func prepareHK(text string) string {
 			if field == "Family name" {
 				vv := strings.TrimSpace(vs[1])
 				if vv != "" && vv != "." {
-					result += " " + vv
+					result.WriteString(" ")
+					result.WriteString(vv)
 				}
 				continue
 			}
 		} else {
 			if addressToken {
-				result += ", " + v
+				result.WriteString(", ")
+				result.WriteString(v)
 				continue
 			}
 		}
@@ -405,16 +546,24 @@ func prepareHK(text string) string {
 				v = fmt.Sprintf("%s %s", token, v)
 			}
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 var prepareTWEmailRx = regexp.MustCompile(`(.*)\s+([^\s]+@[^\s]+)`)
 
 // prepareTW do prepare the .tw domain
 func prepareTW(text string) string { //nolint:cyclop
+	text = prepareZH(text, map[string]string{
+		"註冊人":   "Registrant",
+		"管理聯絡人": "Administrative Contact",
+		"技術聯絡人": "Technical Contact",
+		"聯絡人":   "Contact",
+	})
+
 	tokens := map[string][]string{
 		"Registrant:": {
 			"Organization",
@@ -445,7 +594,7 @@ func prepareTW(text string) string { //nolint:cyclop
 	token := ""
 	index := -1
 
-	result := ""
+	var result strings.Builder
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
 		if token == "" && v == "" {
@@ -464,7 +613,8 @@ func prepareTW(text string) string { //nolint:cyclop
 			token = v
 		} else {
 			if token == "" {
-				result += "\n" + v
+				result.WriteByte('\n')
+				result.WriteString(v)
 			} else {
 				index++
 				if index > len(tokens[token])-1 {
@@ -478,11 +628,15 @@ func prepareTW(text string) string { //nolint:cyclop
 						index++
 					} else if index == 1 {
 						// Organization two line, join it
-						result = strings.TrimSpace(result)
-						if !strings.HasSuffix(result, ":") {
-							result += ", " + v
+						joined := strings.TrimSpace(result.String())
+						result.Reset()
+						result.WriteString(joined)
+						if !strings.HasSuffix(joined, ":") {
+							result.WriteString(", ")
+							result.WriteString(v)
 						} else {
-							result += " " + v
+							result.WriteString(" ")
+							result.WriteString(v)
 						}
 						continue
 					}
@@ -501,19 +655,19 @@ func prepareTW(text string) string { //nolint:cyclop
 					ins := strings.Split(indexName, ",")
 					m := prepareTWEmailRx.FindStringSubmatch(v)
 					if len(m) == 3 {
-						result += fmt.Sprintf("\n%s %s: %s", tokenName, ins[0], strings.TrimSpace(m[1]))
-						result += fmt.Sprintf("\n%s %s: %s", tokenName, ins[1], strings.TrimSpace(m[2]))
+						fmt.Fprintf(&result, "\n%s %s: %s", tokenName, ins[0], strings.TrimSpace(m[1]))
+						fmt.Fprintf(&result, "\n%s %s: %s", tokenName, ins[1], strings.TrimSpace(m[2]))
 					} else {
-						result += fmt.Sprintf("\n%s %s: %s", tokenName, ins[0], strings.TrimSpace(v))
+						fmt.Fprintf(&result, "\n%s %s: %s", tokenName, ins[0], strings.TrimSpace(v))
 					}
 					continue
 				}
-				result += fmt.Sprintf("\n%s %s: %s", tokenName, indexName, v)
+				fmt.Fprintf(&result, "\n%s %s: %s", tokenName, indexName, v)
 			}
 		}
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareCH do prepare the .ch domain
@@ -528,7 +682,16 @@ func prepareCH(text string) string {
 		"First registration date": {},
 	}
 
-	var result string
+	localized := map[string]string{
+		"Domainname":                      "Domain name",
+		"Nom de domaine":                  "Domain name",
+		"Nameserver":                      "Name servers",
+		"Serveurs de noms":                "Name servers",
+		"Erstregistrierungsdatum":         "First registration date",
+		"Date de la première inscription": "First registration date",
+	}
+
+	var result strings.Builder
 	var lastToken string
 	var lastTokenIndex int
 
@@ -537,6 +700,12 @@ func prepareCH(text string) string {
 		if v == "" {
 			continue
 		}
+		for l, t := range localized {
+			if strings.HasPrefix(strings.ToLower(v)+" ", strings.ToLower(l+" ")) {
+				v = t + v[len(l):]
+				break
+			}
+		}
 		for t := range tokens {
 			if strings.HasPrefix(strings.ToLower(v)+" ", strings.ToLower(t+" ")) {
 				lastToken = t
@@ -553,16 +722,19 @@ func prepareCH(text string) string {
 				lastTokenIndex++
 				v = strings.TrimSpace(v[len(phoneMark)-1:])
 			}
-			result += fmt.Sprintf("\n%s: %s", tokens[lastToken][lastTokenIndex], v)
+			if lastTokenIndex >= len(tokens[lastToken]) {
+				continue
+			}
+			fmt.Fprintf(&result, "\n%s: %s", tokens[lastToken][lastTokenIndex], v)
 			if tokens[lastToken][lastTokenIndex] != "Registrar street" {
 				lastTokenIndex++
 			}
 		} else {
-			result += fmt.Sprintf("\n%s: %s", lastToken, v)
+			fmt.Fprintf(&result, "\n%s: %s", lastToken, v)
 		}
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareIT do prepare the .it domain
@@ -578,7 +750,7 @@ func prepareIT(text string) string {
 	topToken := ""
 	subToken := ""
 
-	result := ""
+	var result strings.Builder
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
 		if v == "" {
@@ -593,19 +765,20 @@ func prepareIT(text string) string {
 				subToken = vs[0]
 			} else {
 				if subToken != "" {
-					result += ", " + v
+					result.WriteString(", ")
+					result.WriteString(v)
 					continue
 				}
 			}
 			if topToken != "" && !strings.Contains(v, ":") {
-				result += fmt.Sprintf("\n%s: %s", topToken, v)
+				fmt.Fprintf(&result, "\n%s: %s", topToken, v)
 			} else {
-				result += fmt.Sprintf("\n%s%s", topToken, v)
+				fmt.Fprintf(&result, "\n%s%s", topToken, v)
 			}
 		}
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareFR do prepare the .fr domain
@@ -624,7 +797,7 @@ func prepareFR(text string) string {
 	newBlock := false
 	hdls := map[string]string{}
 
-	result := ""
+	var result strings.Builder
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
 		if v == "" {
@@ -632,24 +805,27 @@ func prepareFR(text string) string {
 			continue
 		}
 
-		vs := strings.Split(v, ":")
-		if newBlock && strings.TrimSpace(vs[0]) == regToken {
+		key, value, _ := strings.Cut(v, ":")
+		key = strings.TrimSpace(key)
+		value, _, _ = strings.Cut(value, ":")
+		value = strings.TrimSpace(value)
+		if newBlock && key == regToken {
 			token = regToken + " "
-			v = fmt.Sprintf("name: %s", strings.TrimSpace(vs[1]))
+			v = fmt.Sprintf("name: %s", value)
 		}
 
 		newBlock = false
-		if t, ok := tokens[strings.TrimSpace(vs[0])]; ok {
-			hdls[t] = strings.TrimSpace(vs[1])
+		if t, ok := tokens[key]; ok {
+			hdls[t] = value
 		}
 
-		if strings.TrimSpace(vs[0]) == dsToken && strings.TrimSpace(vs[1]) != "" {
+		if key == dsToken && value != "" {
 			v += "\nDNSSEC: signed"
 		}
 
-		if strings.TrimSpace(vs[0]) == hdlToken {
+		if key == hdlToken {
 			for _, kk := range keys(hdls) {
-				if strings.TrimSpace(vs[1]) == hdls[kk] {
+				if value == hdls[kk] {
 					token = kk + " "
 					delete(hdls, kk)
 					break
@@ -657,21 +833,40 @@ func prepareFR(text string) string {
 			}
 		}
 
-		result += fmt.Sprintf("\n%s%s", token, v)
+		fmt.Fprintf(&result, "\n%s%s", token, v)
 	}
 
-	return result
+	return result.String()
+}
+
+// prepareRULabels is the RIPN labels of russian labels in .ru, .su and .рф whois
+var prepareRULabels = map[string]string{
+	"домен":                "domain",
+	"серверы dns":          "nserver",
+	"состояние":            "state",
+	"администратор":        "org",
+	"организация":          "org",
+	"контактное лицо":      "person",
+	"электронная почта":    "e-mail",
+	"регистратор":          "registrar",
+	"дата регистрации":     "created",
+	"оплачен до":           "paid-till",
+	"дата освобождения":    "free-date",
+	"источник":             "source",
+	"последнее обновление": "Last updated on",
 }
 
 // prepareRU do prepare the .ru domain
 func prepareRU(text string) string {
+	text = prepareLabels(text, prepareRULabels)
+
 	tokens := map[string]string{
 		"person": "Registrant Name",
 		"e-mail": "Registrant Email",
 		"org":    "Registrant Organization",
 	}
 
-	result := ""
+	var result strings.Builder
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
 		if v == "" {
@@ -686,14 +881,40 @@ func prepareRU(text string) string {
 		} else if vs[0] == "nserver" {
 			v = strings.ReplaceAll(v, ",", " ")
 		}
-		result += v + "\n"
+		result.WriteString(v)
+		result.WriteByte('\n')
 	}
 
-	return result
+	return result.String()
 }
 
 var prepareJPreplacerRx = regexp.MustCompile(`\n(?:\w+\.\s)?\[(.+?)\][\ ]*(.+?)?`)
 
+// prepareJPLabels is the english labels of JPRS japanese output, the japanese
+// line is dropped if its english label is present, or if it is mapped to empty
+var prepareJPLabels = map[string]string{
+	"ドメイン名":   "Domain Name",
+	"登録者名":    "Registrant",
+	"そしきめい":   "",
+	"組織名":     "Organization",
+	"組織種別":    "Organization Type",
+	"登録担当者":   "Administrative Contact",
+	"技術連絡担当者": "Technical Contact",
+	"ネームサーバ":  "Name Server",
+	"署名鍵":     "Signing Key",
+	"状態":      "Status",
+	"ロック状態":   "Lock Status",
+	"登録年月日":   "Created on",
+	"接続年月日":   "Connected Date",
+	"有効期限":    "Expires on",
+	"最終更新":    "Last Updated",
+	"名前":      "Name",
+	"郵便番号":    "Postal code",
+	"住所":      "Postal Address",
+	"電話番号":    "Phone",
+	"FAX番号":   "Fax",
+}
+
 // prepareJP do prepare the .jp domain
 func prepareJP(text string) string {
 	text = prepareJPreplacerRx.ReplaceAllString(text, "\n$1: $2")
@@ -701,11 +922,20 @@ func prepareJP(text string) string {
 	adminToken := "Contact Information"
 	addressToken := "Postal Address"
 
+	lines := strings.Split(text, "\n")
+	labels := map[string]bool{}
+	for _, v := range lines {
+		if name, _, ok := strings.Cut(v, ":"); ok {
+			labels[strings.TrimSpace(name)] = true
+		}
+	}
+
 	token := ""
 	prefixToken := ""
+	dropped := false
 
-	result := ""
-	for _, v := range strings.Split(text, "\n") {
+	var result strings.Builder
+	for _, v := range lines {
 		v = strings.TrimSpace(v)
 		if v == "" {
 			continue
@@ -713,6 +943,15 @@ func prepareJP(text string) string {
 		if strings.Contains(v, ":") {
 			vs := strings.SplitN(v, ":", 2)
 			token = strings.TrimSpace(vs[0])
+			if label, ok := prepareJPLabels[token]; ok {
+				dropped = label == "" || labels[label]
+				if dropped {
+					continue
+				}
+				token = label
+				v = label + ":" + vs[1]
+			}
+			dropped = false
 			if token == adminToken {
 				prefixToken = "admin "
 			}
@@ -721,15 +960,21 @@ func prepareJP(text string) string {
 			}
 			v = prepareSecondLevelJP(v, token, vs[1])
 		} else {
+			if dropped {
+				continue
+			}
 			if token == addressToken {
-				result += ", " + v
+				result.WriteString(", ")
+				result.WriteString(v)
 				continue
 			}
 		}
-		result += "\n" + prefixToken + v
+		result.WriteByte('\n')
+		result.WriteString(prefixToken)
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareJP prepares specific mappings for second level .jp domains
@@ -761,7 +1006,7 @@ func prepareUK(text string) string {
 		"URL": "Registrar URL",
 	}
 
-	result := ""
+	var result strings.Builder
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
 		if v == "" {
@@ -773,10 +1018,11 @@ func prepareUK(text string) string {
 				v = fmt.Sprintf("%s: %s", vv, vs[1])
 			}
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareKR do prepare the .kr domain
@@ -795,7 +1041,7 @@ func prepareKR(text string) string {
 		text = text[pos+len(english):]
 	}
 
-	result := ""
+	var result strings.Builder
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
 		if v == "" {
@@ -810,15 +1056,16 @@ func prepareKR(text string) string {
 				v = fmt.Sprintf("%s: %s", vv, vs[1])
 			}
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareNZ do prepare the .nz domain
 func prepareNZ(text string) string {
-	result := ""
+	var result strings.Builder
 
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
@@ -828,10 +1075,11 @@ func prepareNZ(text string) string {
 				v = fmt.Sprintf("name server: %s", vs[1])
 			}
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareTK do prepare the .tk domain
@@ -858,7 +1106,7 @@ func prepareTK(text string) string {
 	}
 
 	token := ""
-	result := ""
+	var result strings.Builder
 	index := 0
 
 	for _, v := range strings.Split(text, "\n") {
@@ -869,12 +1117,16 @@ func prepareTK(text string) string {
 		}
 		if _, ok := tokens[v]; ok {
 			token = tokens[v]
+			index = 0
 			continue
 		}
 		if token == "Domain" && strings.Contains(v, " is ") {
 			vv := strings.Split(v, " is ")
 			v = fmt.Sprintf("Name: %s\nStatus: %s", vv[0], vv[1])
 		} else if token == "Registrant" && !strings.Contains(v, ":") {
+			if index >= len(fields[token]) {
+				continue
+			}
 			v = fmt.Sprintf("%s: %s", fields[token][index], v)
 			index++
 		}
@@ -885,10 +1137,11 @@ func prepareTK(text string) string {
 				v = fmt.Sprintf("%s %s", token, v)
 			}
 		}
-		result += "\n" + strings.TrimSpace(v)
+		result.WriteByte('\n')
+		result.WriteString(strings.TrimSpace(v))
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareNL do prepare the .nl domain
@@ -910,10 +1163,14 @@ func prepareNL(text string) string {
 		},
 	}
 
+	localized := map[string]string{
+		"Wederverkoper:": "Reseller:",
+	}
+
 	token := ""
 	index := 0
 
-	result := ""
+	var result strings.Builder
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
 		if v == "" {
@@ -923,19 +1180,23 @@ func prepareNL(text string) string {
 			token = ""
 			index = 0
 		}
+		if t, ok := localized[v]; ok {
+			v = t
+		}
 		if _, ok := tokens[v]; ok {
 			token = v
 		} else {
 			if token == "" {
-				result += "\n" + v
-			} else {
-				result += fmt.Sprintf("\n%s %s: %s", token[:len(token)-1], tokens[token][index], v)
+				result.WriteByte('\n')
+				result.WriteString(v)
+			} else if index < len(tokens[token]) {
+				fmt.Fprintf(&result, "\n%s %s: %s", token[:len(token)-1], tokens[token][index], v)
 				index++
 			}
 		}
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareEU do prepare the .eu domain
@@ -949,7 +1210,7 @@ func prepareEU(text string) string {
 	}
 
 	token := ""
-	result := ""
+	var result strings.Builder
 
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
@@ -971,10 +1232,11 @@ func prepareEU(text string) string {
 				v = fmt.Sprintf("%s: %s", token, v)
 			}
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareBR do prepare the .br domain
@@ -988,7 +1250,7 @@ func prepareBR(text string) string {
 	}
 
 	token := ""
-	hdlMap := map[string]string{}
+	hdlMap := map[string][]string{}
 
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
@@ -1000,15 +1262,15 @@ func prepareBR(text string) string {
 			vs := strings.SplitN(v, ":", 2)
 			if strings.TrimSpace(vs[0]) == hdlToken {
 				token = strings.TrimSpace(vs[1])
-				hdlMap[token] = ""
+				hdlMap[token] = nil
 			}
 		}
 		if token != "" {
-			hdlMap[token] += "\n" + v
+			hdlMap[token] = append(hdlMap[token], v)
 		}
 	}
 
-	result := ""
+	var result strings.Builder
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
 		if v == "" {
@@ -1020,19 +1282,17 @@ func prepareBR(text string) string {
 				v = fmt.Sprintf("registrant organization: %s", vs[1])
 			}
 			if vv, ok := tokens[strings.TrimSpace(vs[0])]; ok {
-				for _, tt := range strings.Split(hdlMap[strings.TrimSpace(vs[1])], "\n") {
-					if strings.TrimSpace(tt) == "" {
-						continue
-					}
-					result += fmt.Sprintf("\n%s %s", vv, tt)
+				for _, tt := range hdlMap[strings.TrimSpace(vs[1])] {
+					fmt.Fprintf(&result, "\n%s %s", vv, tt)
 				}
 				continue
 			}
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareIR do prepare the .ir domain
@@ -1046,7 +1306,7 @@ func prepareIR(text string) string {
 	}
 
 	token := ""
-	hdlMap := map[string]string{}
+	hdlMap := map[string][]string{}
 
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
@@ -1058,15 +1318,15 @@ func prepareIR(text string) string {
 			vs := strings.SplitN(v, ":", 2)
 			if strings.TrimSpace(vs[0]) == hdlToken {
 				token = strings.TrimSpace(vs[1])
-				hdlMap[token] = ""
+				hdlMap[token] = nil
 			}
 		}
 		if token != "" {
-			hdlMap[token] += "\n" + v
+			hdlMap[token] = append(hdlMap[token], v)
 		}
 	}
 
-	result := ""
+	var result strings.Builder
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
 		if v == "" {
@@ -1075,19 +1335,17 @@ func prepareIR(text string) string {
 		if strings.Contains(v, ":") {
 			vs := strings.SplitN(v, ":", 2)
 			if vv, ok := tokens[strings.TrimSpace(vs[0])]; ok {
-				for _, tt := range strings.Split(hdlMap[strings.TrimSpace(vs[1])], "\n") {
-					if strings.TrimSpace(tt) == "" {
-						continue
-					}
-					result += fmt.Sprintf("\n%s %s", vv, tt)
+				for _, tt := range hdlMap[strings.TrimSpace(vs[1])] {
+					fmt.Fprintf(&result, "\n%s %s", vv, tt)
 				}
 				continue
 			}
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareFI do prepare the .fi domain
@@ -1099,7 +1357,7 @@ func prepareFI(text string) string {
 	}
 
 	token := ""
-	result := ""
+	var result strings.Builder
 
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
@@ -1124,10 +1382,11 @@ func prepareFI(text string) string {
 				v = fmt.Sprintf("%s %s", token, v)
 			}
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareRS do prepare the .rs domain
@@ -1139,7 +1398,7 @@ func prepareRS(text string) string {
 	}
 
 	token := ""
-	result := ""
+	var result strings.Builder
 
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
@@ -1156,10 +1415,11 @@ func prepareRS(text string) string {
 				v = fmt.Sprintf("%s %s", token, v)
 			}
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareEE do prepare the .ee domain
@@ -1174,7 +1434,7 @@ func prepareEE(text string) string {
 	}
 
 	token := ""
-	result := ""
+	var result strings.Builder
 
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
@@ -1187,15 +1447,18 @@ func prepareEE(text string) string {
 			continue
 		}
 		v = fmt.Sprintf("%s %s", token, v)
-		result += "\n" + strings.TrimSpace(v)
+		result.WriteByte('\n')
+		result.WriteString(strings.TrimSpace(v))
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareCN do prepare the .cn domain
 func prepareCN(text string) string {
-	var result string
+	text = prepareZH(text, nil)
+
+	var result strings.Builder
 
 	for _, v := range strings.Split(text, "\n") {
 		v = strings.TrimSpace(v)
@@ -1206,22 +1469,23 @@ func prepareCN(text string) string {
 			}
 			v = fmt.Sprintf("%s: %s", vs[0], vs[1])
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 // preparePL prepares the .pl domain
 func preparePL(text string) string {
-	result := ""
+	var result strings.Builder
 	special := ""
 	registrarLine := 0
 	for _, v := range strings.Split(text, "\n") {
 		if special == "nameservers" {
 			if strings.HasPrefix(v, " ") {
 				ns := strings.SplitN(v, "[", 2)
-				result += fmt.Sprintf("\nnameservers: %s", strings.TrimSpace(ns[0]))
+				fmt.Fprintf(&result, "\nnameservers: %s", strings.TrimSpace(ns[0]))
 				continue
 			}
 			special = ""
@@ -1232,10 +1496,10 @@ func preparePL(text string) string {
 				switch registrarLine {
 				case 0:
 					// always name
-					result += fmt.Sprintf("\nregistrar name: %s", strings.TrimSpace(v))
+					fmt.Fprintf(&result, "\nregistrar name: %s", strings.TrimSpace(v))
 				case 1:
 					// always street address
-					result += fmt.Sprintf("\nregistrar street: %s", strings.TrimSpace(v))
+					fmt.Fprintf(&result, "\nregistrar street: %s", strings.TrimSpace(v))
 				case 2:
 					// postal code, city, state, sometimes country in an undefined format
 					// there's no way we can reliably unpack that
@@ -1245,15 +1509,15 @@ func preparePL(text string) string {
 					// usually country unless it was on previous line, then phones/emails/www
 					if strings.Contains(v, "@") {
 						// email may have an "e-mail" prefix, but mostly does not
-						result += fmt.Sprintf("\nregistrar email: %s", strings.TrimSpace(strings.TrimLeft(v, "e-mail:")))
+						fmt.Fprintf(&result, "\nregistrar email: %s", strings.TrimSpace(strings.TrimLeft(v, "e-mail:")))
 					} else if strings.HasPrefix(v, "+") {
 						// phone numbers helpfully always start with +
-						result += fmt.Sprintf("\nregistrar phone: %s", strings.TrimSpace(v))
+						fmt.Fprintf(&result, "\nregistrar phone: %s", strings.TrimSpace(v))
 					} else if strings.Contains(v, ".") {
 						// WWW addresses sometimes include http/https, sometimes do not
-						result += fmt.Sprintf("\nregistrar www: %s", strings.TrimSpace(v))
+						fmt.Fprintf(&result, "\nregistrar www: %s", strings.TrimSpace(v))
 					} else {
-						result += fmt.Sprintf("\nregistrar country: %s", strings.TrimSpace(v))
+						fmt.Fprintf(&result, "\nregistrar country: %s", strings.TrimSpace(v))
 					}
 				}
 				registrarLine++
@@ -1269,7 +1533,7 @@ func preparePL(text string) string {
 		if strings.HasPrefix(v, "nameservers: ") {
 			special = "nameservers"
 			ns := strings.SplitN(v, "[", 2)
-			result += fmt.Sprintf("\n%s", strings.TrimSpace(ns[0]))
+			fmt.Fprintf(&result, "\n%s", strings.TrimSpace(ns[0]))
 			continue
 		}
 
@@ -1278,29 +1542,64 @@ func preparePL(text string) string {
 			continue
 		}
 
-		result += fmt.Sprintf("\n%s", strings.ReplaceAll(v, "WHOIS database responses:", "whois:"))
+		fmt.Fprintf(&result, "\n%s", strings.ReplaceAll(v, "WHOIS database responses:", "whois:"))
 	}
 
-	return result
+	return result.String()
+}
+
+// prepareDKLabels is the english labels of danish labels in .dk whois
+var prepareDKLabels = map[string]string{
+	"domæne":               "Domain",
+	"registreret":          "Registered",
+	"udløber":              "Expires",
+	"registreringsperiode": "Registration period",
+	"navn":                 "Name",
+	"adresse":              "Address",
+	"postnummer":           "Postalcode",
+	"by":                   "City",
+	"land":                 "Country",
+	"telefon":              "Phone",
+	"værtsnavn":            "Hostname",
 }
 
 // prepareDK do prepare the .dk domain
 func prepareDK(text string) string {
-	var result string
+	var result strings.Builder
 
+	text = prepareLabels(text, prepareDKLabels)
 	for _, v := range strings.Split(text, "\n") {
 		if strings.HasPrefix(v, "DNS:") {
 			continue
 		}
-		result += v + "\n"
+		result.WriteString(v)
+		result.WriteByte('\n')
 	}
 
-	return result
+	return result.String()
+}
+
+// prepareBYLabels is the english labels of russian labels in .by whois
+var prepareBYLabels = map[string]string{
+	"имя домена":        "Domain name",
+	"регистратор":       "Registrar",
+	"контактное лицо":   "Person",
+	"организация":       "Org",
+	"страна":            "Country",
+	"адрес":             "Address",
+	"телефон":           "Phone",
+	"электронная почта": "Email",
+	"сервер имен":       "Name Server",
+	"дата обновления":   "Update Date",
+	"дата создания":     "Creation Date",
+	"дата окончания":    "Expiration Date",
 }
 
 // prepareBY do prepare the .by domain
 func prepareBY(text string) string {
-	var result string
+	text = prepareLabels(text, prepareBYLabels)
+
+	var result strings.Builder
 
 	tokens := map[string]string{
 		"Person":  "Registrant Person",
@@ -1319,15 +1618,43 @@ func prepareBY(text string) string {
 				v = fmt.Sprintf("%s: %s", t, vs[1])
 			}
 		}
-		result += v + "\n"
+		result.WriteString(v)
+		result.WriteByte('\n')
 	}
 
-	return result
+	return result.String()
+}
+
+// prepareUALabels is the english labels of ukrainian labels in .ua and .укр whois
+var prepareUALabels = map[string]string{
+	"% реєстратор":               "% Registrar",
+	"% реєстрант":                "% Registrant",
+	"% адміністративні контакти": "% Administrative Contacts",
+	"% технічні контакти":        "% Technical Contacts",
+	"домен":                      "domain",
+	"сервер імен":                "nserver",
+	"статус":                     "status",
+	"створено":                   "created",
+	"змінено":                    "modified",
+	"закінчується":               "expires",
+	"джерело":                    "source",
+	"реєстратор":                 "registrar",
+	"організація":                "organization",
+	"особа":                      "person",
+	"електронна пошта":           "e-mail",
+	"адреса":                     "address",
+	"поштовий індекс":            "postal-code",
+	"місто":                      "city",
+	"країна":                     "country",
+	"телефон":                    "phone",
+	"факс":                       "fax",
 }
 
 // prepareUA do prepare the .ua domain
 func prepareUA(text string) string {
-	var result string
+	text = prepareLabels(text, prepareUALabels)
+
+	var result strings.Builder
 
 	tokens := map[string]string{
 		"% Registrar:":               "Registrar",
@@ -1346,30 +1673,49 @@ func prepareUA(text string) string {
 			continue
 		}
 		if token != "" && v != "" && !strings.HasPrefix(v, "%") {
-			vs := strings.SplitN(v, ":", 2)
-			vs[0] = strings.TrimSuffix(strings.TrimSpace(vs[0]), "-loc")
-			if vs[0] == "registrar" {
-				vs[0] = "name"
-			}
-			vs[1] = strings.TrimSpace(vs[1])
-			if vs[1] == "n/a" {
-				continue
+			if name, value, ok := strings.Cut(v, ":"); ok {
+				name = strings.TrimSuffix(strings.TrimSpace(name), "-loc")
+				if name == "registrar" {
+					name = "name"
+				}
+				value = strings.TrimSpace(value)
+				if value == "n/a" {
+					continue
+				}
+				v = fmt.Sprintf("%s %s:%s", token, name, value)
 			}
-			v = fmt.Sprintf("%s %s", token, strings.Join(vs, ":"))
 		}
 		if v != "" && uniqueLine[v] {
 			continue
 		}
 		uniqueLine[v] = true
-		result += v + "\n"
+		result.WriteString(v)
+		result.WriteByte('\n')
 	}
 
-	return result
+	return result.String()
+}
+
+// prepareATLabels is the english labels of german labels in .at whois
+var prepareATLabels = map[string]string{
+	"domäne":              "domain",
+	"inhaber":             "registrant",
+	"technischer kontakt": "tech-c",
+	"geändert":            "changed",
+	"zuletzt geändert":    "changed",
+	"personenname":        "personname",
+	"organisation":        "organization",
+	"straße":              "street address",
+	"postleitzahl":        "postal code",
+	"ort":                 "city",
+	"land":                "country",
+	"telefon":             "phone",
+	"quelle":              "source",
 }
 
 // prepareAT prepares the .at domain
 func prepareAT(text string) string {
-	result := ""
+	var result strings.Builder
 	registrantID := ""
 	techID := ""
 
@@ -1383,6 +1729,8 @@ func prepareAT(text string) string {
 		"personname":     "name",
 	}
 
+	text = prepareLabels(text, prepareATLabels)
+
 	formatLine := func(line, token string) string {
 		before, after, _ := strings.Cut(line, ":")
 		key := strings.TrimSpace(before)
@@ -1421,7 +1769,8 @@ func prepareAT(text string) string {
 							w = fmt.Sprintf("domain %s: %s", key, val)
 						}
 						if w != "" {
-							result += w + "\n"
+							result.WriteString(w)
+							result.WriteByte('\n')
 						}
 					}
 				}
@@ -1433,13 +1782,14 @@ func prepareAT(text string) string {
 					token = "technical contact"
 				}
 				for _, l := range strings.Split(v, "\n") {
-					result += formatLine(l, token) + "\n"
+					result.WriteString(formatLine(l, token))
+					result.WriteByte('\n')
 				}
 			}
 		}
 	}
 
-	return result
+	return result.String()
 }
 
 // prepareSK do prepare the .sk domain
@@ -1452,7 +1802,7 @@ func prepareSK(text string) string {
 		"Technical Contact":      "Technical",
 	}
 
-	result := ""
+	var result strings.Builder
 	prefix := ""
 
 	for _, v := range strings.Split(text, "\n") {
@@ -1477,10 +1827,11 @@ func prepareSK(text string) string {
 			v = fmt.Sprintf("%s%s:%s", prefix, token, value)
 
 		}
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 	}
 
-	return result
+	return result.String()
 }
 
 func prepareGG(text string) string {
@@ -1490,7 +1841,7 @@ func prepareGG(text string) string {
 		"Registrar":      "registrar_name",
 	}
 
-	result := ""
+	var result strings.Builder
 	previousSectionHeader := ""
 	sectionHeader := ""
 
@@ -1553,11 +1904,12 @@ func prepareGG(text string) string {
 			v = fmt.Sprintf("%s: %s", tokens[previousSectionHeader], v)
 		}
 
-		result += "\n" + v
+		result.WriteByte('\n')
+		result.WriteString(v)
 
 		previousSectionHeader = sectionHeader
 	}
 
-	return result
+	return result.String()
 
 }
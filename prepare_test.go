@@ -21,52 +21,265 @@ package whoisparser
 
 import (
 	"fmt"
+	"path"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/likexian/gokit/assert"
 	"github.com/likexian/gokit/xfile"
 )
 
 func TestPrepare(t *testing.T) {
-	dirs, err := xfile.ListDir(noterrorDir, xfile.TypeFile, -1)
-	assert.Nil(t, err)
+	for _, v := range listCorpus(t, noterrorDir) {
+		whoisRaw, err := xfile.ReadText(noterrorDir + "/" + v.name)
+		assert.Nil(t, err)
 
-	for _, v := range dirs {
-		if v.Name == "README.md" {
-			continue
+		whoisPrepare, prepared := Prepare(whoisRaw, v.extension)
+		if prepared {
+			assertGoldenText(t, fmt.Sprintf("%s/%s.pre", noterrorDir, v.name), strings.TrimSpace(whoisPrepare))
 		}
+	}
+}
 
-		domain := strings.Split(v.Name, "_")[1]
-		extension := ""
-		if strings.Contains(v.Name, ".") {
-			extension = domain[strings.LastIndex(domain, ".")+1:]
-		}
+func TestPrepareZHLabels(t *testing.T) {
+	for _, ext := range []string{"cn", "tw", "hk", "mo"} {
+		whoisPrepare, _ := Prepare("域名: example."+ext+"\n注册人ID: a\n註冊人ID: b\n持有人id: c\n", ext)
+		assert.Equal(t, strings.Count(whoisPrepare, "Registrant ID:"), 3, ext)
 
-		if assert.IsContains([]string{"pre", "out"}, extension) {
-			continue
-		}
+		whoisInfo, err := Parse("域名: example." + ext + "\n持有人ID: c\n")
+		assert.Nil(t, err, ext)
+		assert.Equal(t, whoisInfo.Registrant.ID, "c", ext)
+	}
+}
 
-		whoisRaw, err := xfile.ReadText(noterrorDir + "/" + v.Name)
-		assert.Nil(t, err)
+func TestParseZHLabels(t *testing.T) {
+	whoisInfo, err := Parse(`域名：aliyun.cn
+ROID：20030310s10001s00412567-cn
+域名状态：clientDeleteProhibited
+注册人ID：hc401628758-cn
+注册人：阿里云计算有限公司
+注册人邮箱：domainadmin@service.aliyun.com
+注册商：阿里云计算有限公司（万网）
+域名服务器：ns1.aliyun.com
+注册时间：2003-03-10 19:12:33
+过期时间：2031-03-10 19:12:33
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "aliyun.cn")
+	assert.Equal(t, whoisInfo.Domain.ID, "20030310s10001s00412567-cn")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"clientDeleteProhibited"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.aliyun.com"})
+	assert.NotNil(t, whoisInfo.Domain.CreatedDateInTime)
+	assert.NotNil(t, whoisInfo.Domain.ExpirationDateInTime)
+	assert.Equal(t, whoisInfo.Registrar.Name, "阿里云计算有限公司（万网）")
+	assert.Equal(t, whoisInfo.Registrant.ID, "hc401628758-cn")
+	assert.Equal(t, whoisInfo.Registrant.Name, "阿里云计算有限公司")
+	assert.Equal(t, whoisInfo.Registrant.Email, "domainadmin@service.aliyun.com")
 
-		whoisPrepare, prepared := Prepare(whoisRaw, extension)
-		if prepared {
-			prePrepare := ""
-			whoisPrepare = strings.TrimSpace(whoisPrepare)
+	whoisInfo, err = Parse(`域名: twnic.tw
+   域名狀態: clientUpdateProhibited,clientTransferProhibited
+   註冊人:
+      財團法人台灣網路資訊中心
+      Taiwan Network Information Center
+      網域管理員  hostmaster@twnic.tw
+      +886.225411950
+      +886.225412980
+      台北市中山區
+      TW
+
+   技術聯絡人:
+      網域管理員  hostmaster@twnic.tw
+      +886.225411950
+
+   到期日期: 2031-09-30
+   註冊日期: 2001-09-21
+
+   域名伺服器:
+      ns.twnic.net.tw
+
+註冊服務機構: 台灣網路資訊中心
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "twnic.tw")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"clientUpdateProhibited", "clientTransferProhibited"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns.twnic.net.tw"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDate, "2001-09-21")
+	assert.Equal(t, whoisInfo.Domain.ExpirationDate, "2031-09-30")
+	assert.Equal(t, whoisInfo.Registrar.Name, "台灣網路資訊中心")
+	assert.Equal(t, whoisInfo.Registrant.Name, "網域管理員")
+	assert.Equal(t, whoisInfo.Registrant.Organization, "財團法人台灣網路資訊中心, Taiwan Network Information Center")
+	assert.Equal(t, whoisInfo.Registrant.Street, "台北市中山區, TW")
+	assert.Equal(t, whoisInfo.Registrant.Fax, "+886.225412980")
+	assert.Equal(t, whoisInfo.Technical.Email, "hostmaster@twnic.tw")
+	assert.Equal(t, whoisInfo.Technical.Phone, "+886.225411950")
+
+	whoisInfo, err = Parse(`域名：  HKIRC.HK
+域名狀態： Active
+註冊服務商名稱： HONG KONG DOMAIN NAME REGISTRATION COMPANY LIMITED
+註冊服務商聯絡資料： Email: enquiry@hkdnr.hk Hotline: +852 2319 1313
+
+註冊人聯絡資料：
+公司英文名稱： HONG KONG INTERNET REGISTRATION CORPORATION LIMITED
+公司中文名稱： 香港互聯網註冊管理有限公司
+國家： Hong Kong (HK)
+電郵：  hostmaster@hkirc.hk
+域名註冊日期： 11-08-1997
+屆滿日期： 31-12-2030
+
+技術聯絡人資料：
+名字：  DOMAIN
+姓氏：  ADMINISTRATOR
+電話：  +852-23192303
+
+域名伺服器資料：
+NS1.HKIRC.NET.HK
 
-			preFile := fmt.Sprintf(noterrorDir+"/%s.pre", v.Name)
-			if xfile.Exists(preFile) {
-				prePrepare, err = xfile.ReadText(preFile)
-				assert.Nil(t, err)
-			}
 
-			err = xfile.WriteText(preFile, whoisPrepare)
-			assert.Nil(t, err)
 
-			if prePrepare != "" {
-				assert.Equal(t, whoisPrepare, prePrepare)
+狀態資料：
+Domain Prohibit Status:
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "hkirc.hk")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"Active"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.hkirc.net.hk"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDateInTime.Format("2006-01-02"), "1997-08-11")
+	assert.Equal(t, whoisInfo.Domain.ExpirationDateInTime.Format("2006-01-02"), "2030-12-31")
+	assert.Equal(t, whoisInfo.Registrar.Name, "HONG KONG DOMAIN NAME REGISTRATION COMPANY LIMITED")
+	assert.Equal(t, whoisInfo.Registrar.Email, "enquiry@hkdnr.hk")
+	assert.Equal(t, whoisInfo.Registrar.Phone, "+852 2319 1313")
+	assert.Equal(t, whoisInfo.Registrant.Organization, "HONG KONG INTERNET REGISTRATION CORPORATION LIMITED")
+	assert.Equal(t, whoisInfo.Registrant.Country, "Hong Kong (HK)")
+	assert.Equal(t, whoisInfo.Registrant.Email, "hostmaster@hkirc.hk")
+	assert.Equal(t, whoisInfo.Technical.Name, "DOMAIN ADMINISTRATOR")
+	assert.Equal(t, whoisInfo.Technical.Phone, "+852-23192303")
+
+	whoisInfo, err = Parse(`域名： macaodaily.mo
+註冊日期： 2001-06-15 10:21:35
+到期日期： 2027-06-15
+
+註冊人：
+-----------------------------------------------------
+機構：          澳門日報有限公司
+姓名：          陳大文
+城市：          澳門
+國家/地區：     MO
+電郵：          admin@macaodaily.com
+
+技術聯絡人：
+-----------------------------------------------------
+姓名：          李小明
+電話：          +853.28371688
+電郵：          tech@macaodaily.com
+
+域名伺服器：
+-----------------------------------------------------
+ns1.macaodaily.com
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "macaodaily.mo")
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.macaodaily.com"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDate, "2001-06-15 10:21:35")
+	assert.Equal(t, whoisInfo.Domain.ExpirationDate, "2027-06-15")
+	assert.Equal(t, whoisInfo.Registrant.Name, "陳大文")
+	assert.Equal(t, whoisInfo.Registrant.Organization, "澳門日報有限公司")
+	assert.Equal(t, whoisInfo.Registrant.City, "澳門")
+	assert.Equal(t, whoisInfo.Registrant.Country, "MO")
+	assert.Equal(t, whoisInfo.Registrant.Email, "admin@macaodaily.com")
+	assert.Equal(t, whoisInfo.Technical.Name, "李小明")
+	assert.Equal(t, whoisInfo.Technical.Phone, "+853.28371688")
+	assert.Equal(t, whoisInfo.Technical.Email, "tech@macaodaily.com")
+}
+
+func TestPrepareJPLabels(t *testing.T) {
+	whoisInfo, err := Parse(`Domain Information: [ドメイン情報]
+[Domain Name]                   JPRS.JP
+
+[登録者名]                      株式会社日本レジストリサービス
+[Registrant]                    Japan Registry Services Co.,Ltd.
+
+[Name Server]                   ns1.jprs.jp
+[登録年月日]                    2001/02/02
+[有効期限]                      2025/02/28
+[状態]                          Active
+[最終更新]                      2024/03/01 01:05:07 (JST)
+
+Contact Information: [公開連絡窓口]
+[名前]                          株式会社日本レジストリサービス
+[Name]                          Japan Registry Services Co.,Ltd.
+[Email]                         admin@jprs.jp
+[郵便番号]                      101-0065
+[住所]                          東京都千代田区西神田3-8-1
+                                千代田ファーストビル東館
+[Postal Address]                Chiyoda First Bldg. East
+                                Tokyo
+[電話番号]                      03-5215-8451
+[FAX番号]                       03-5215-8452
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "jprs.jp")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"Active"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDate, "2001/02/02")
+	assert.Equal(t, whoisInfo.Domain.ExpirationDate, "2025/02/28")
+	assert.Equal(t, whoisInfo.Domain.UpdatedDateInTime.Format(time.RFC3339), "2024-03-01T01:05:07+09:00")
+	assert.Equal(t, whoisInfo.Registrant.Name, "Japan Registry Services Co.,Ltd.")
+	assert.Equal(t, whoisInfo.Administrative.Name, "Japan Registry Services Co.,Ltd.")
+	assert.Equal(t, whoisInfo.Administrative.Street, "Chiyoda First Bldg. East, Tokyo")
+	assert.Equal(t, whoisInfo.Administrative.PostalCode, "101-0065")
+	assert.Equal(t, whoisInfo.Administrative.Phone, "03-5215-8451")
+	assert.Equal(t, whoisInfo.Administrative.Fax, "03-5215-8452")
+
+	whoisInfo, err = Parse(`Domain Information: [ドメイン情報]
+a. [ドメイン名]                 JPRS.CO.JP
+e. [そしきめい]                 かぶしきがいしゃにほんれじすとりさーびす
+f. [組織名]                     株式会社日本レジストリサービス
+g. [Organization]               Japan Registry Services Co.,Ltd.
+m. [登録担当者]                 HT4316JP
+n. [技術連絡担当者]             KY1503JP
+p. [ネームサーバ]               ns1.jprs.co.jp
+[状態]                          Connected (2025/12/31)
+[登録年月日]                    2000/12/26
+[最終更新]                      2025/01/01 01:12:08 (JST)
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "jprs.co.jp")
+	assert.Equal(t, whoisInfo.Domain.Status, []string{"Connected"})
+	assert.Equal(t, whoisInfo.Domain.NameServers, []string{"ns1.jprs.co.jp"})
+	assert.Equal(t, whoisInfo.Domain.CreatedDate, "2000/12/26")
+	assert.Equal(t, whoisInfo.Registrant.Organization, "Japan Registry Services Co.,Ltd.")
+	assert.Equal(t, whoisInfo.Administrative.ID, "HT4316JP")
+	assert.Equal(t, whoisInfo.Technical.ID, "KY1503JP")
+}
+
+func BenchmarkPrepare(b *testing.B) {
+	corpus := readCorpus(b, noterrorDir)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for k, v := range corpus {
+			_, _ = Prepare(v, k.extension)
+		}
+	}
+}
+
+func BenchmarkPrepareExtension(b *testing.B) {
+	for _, dir := range []string{noterrorDir, notfoundDir} {
+		groups := groupCorpus(readCorpus(b, dir))
+		for _, extension := range sortedKeys(groups) {
+			if _, prepared := Prepare("", extension); !prepared {
+				continue
 			}
+			b.Run(path.Base(dir)+"/"+benchName(extension), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					for _, v := range groups[extension] {
+						_, _ = Prepare(v, extension)
+					}
+				}
+			})
 		}
 	}
 }
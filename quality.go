@@ -0,0 +1,190 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"reflect"
+	"strings"
+)
+
+const (
+	// invalidDatePenalty is the confidence penalty of each invalid date
+	invalidDatePenalty = 0.1
+	// placeholderPenalty is the confidence penalty of each placeholder value
+	placeholderPenalty = 0.02
+	// maxPlaceholderPenalty is the max confidence penalty of placeholder values
+	maxPlaceholderPenalty = 0.2
+)
+
+var (
+	// placeholderValues is the values equal to them are placeholders
+	placeholderValues = []string{
+		"-",
+		"n/a",
+		"na",
+		"none",
+		"null",
+		"nil",
+		"unknown",
+		"private",
+		"redacted",
+		"withheld",
+		"not disclosed",
+		"not available",
+		"not applicable",
+	}
+
+	// placeholderKeys is the values containing them are placeholders
+	placeholderKeys = []string{
+		"redacted for privacy",
+		"data redacted",
+		"redacted for gdpr",
+		"gdpr masked",
+		"statutory masking enabled",
+		"data protected",
+		"not disclosed",
+		"non-public data",
+		"contact privacy",
+		"whoisguard protected",
+		"please query the rdds service",
+		"please query the whois service",
+	}
+)
+
+// Quality storing the completeness and confidence of whois info
+type Quality struct {
+	Missing      []Field `json:"missing,omitempty"`
+	InvalidDates []Field `json:"invalid_dates,omitempty"`
+	Placeholders []Field `json:"placeholders,omitempty"`
+	Confidence   float64 `json:"confidence"`
+}
+
+// Quality returns the quality report of whois info, it lists the fields
+// expected for the extension but missing, the dates could not be parsed
+// and the values look like labels or placeholders, the confidence is from
+// 0 to 1, results with a low confidence should be checked with another source.
+func (w WhoisInfo) Quality() Quality {
+	quality := Quality{
+		Missing:      []Field{},
+		InvalidDates: []Field{},
+		Placeholders: []Field{},
+	}
+
+	if w.Domain == nil || w.Domain.Domain == "" {
+		quality.Missing = append(quality.Missing, Field("domain.domain"))
+		return quality
+	}
+
+	ext := w.Domain.Extension
+	if ext != "" && w.Domain.Punycode != "" {
+		ext = w.Domain.Punycode
+	}
+
+	capability := Capabilities(ext)
+	quality.Missing = capability.Missing(w)
+
+	dates := []struct {
+		field  Field
+		value  string
+		parsed bool
+	}{
+		{FieldDomainCreatedDate, w.Domain.CreatedDate, w.Domain.CreatedDateInTime != nil},
+		{FieldDomainUpdatedDate, w.Domain.UpdatedDate, w.Domain.UpdatedDateInTime != nil},
+		{FieldDomainExpirationDate, w.Domain.ExpirationDate, w.Domain.ExpirationDateInTime != nil},
+	}
+
+	for _, v := range dates {
+		if v.value != "" && !v.parsed {
+			quality.InvalidDates = append(quality.InvalidDates, v.field)
+		}
+	}
+
+	quality.Placeholders = append(quality.Placeholders, searchPlaceholders("domain", w.Domain)...)
+	contacts := []struct {
+		name    string
+		contact *Contact
+	}{
+		{"registrar", w.Registrar},
+		{"registrant", w.Registrant},
+		{"administrative", w.Administrative},
+		{"technical", w.Technical},
+		{"billing", w.Billing},
+	}
+
+	for _, v := range contacts {
+		if v.contact != nil {
+			quality.Placeholders = append(quality.Placeholders, searchPlaceholders(v.name, v.contact)...)
+		}
+	}
+
+	quality.Confidence = 1
+	if len(capability.Fields) > 0 {
+		quality.Confidence = float64(len(capability.Fields)-len(quality.Missing)) / float64(len(capability.Fields))
+	}
+
+	quality.Confidence -= invalidDatePenalty * float64(len(quality.InvalidDates))
+	quality.Confidence -= min(placeholderPenalty*float64(len(quality.Placeholders)), maxPlaceholderPenalty)
+	quality.Confidence = max(quality.Confidence, 0)
+
+	return quality
+}
+
+// searchPlaceholders returns the string fields of struct look like labels or placeholders
+func searchPlaceholders(prefix string, data any) []Field {
+	result := []Field{}
+
+	v := reflect.ValueOf(data).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).Kind() != reflect.String {
+			continue
+		}
+		if isPlaceholder(v.Field(i).String()) {
+			name := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+			result = append(result, Field(prefix+"."+name))
+		}
+	}
+
+	return result
+}
+
+// isPlaceholder returns if value looks like a label or placeholder
+func isPlaceholder(value string) bool {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return false
+	}
+
+	for _, v := range placeholderValues {
+		if value == v {
+			return true
+		}
+	}
+
+	if containsIn(value, placeholderKeys) {
+		return true
+	}
+
+	// label leaked into value, e.g. "Registrant Street:"
+	if strings.HasSuffix(value, ":") || (strings.Contains(value, " ") && searchKeyName(value) != "") {
+		return true
+	}
+
+	return false
+}
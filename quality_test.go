@@ -0,0 +1,103 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/likexian/gokit/assert"
+	"github.com/likexian/gokit/xfile"
+)
+
+func TestQuality(t *testing.T) {
+	quality := WhoisInfo{}.Quality()
+	assert.Equal(t, quality.Missing, []Field{"domain.domain"})
+	assert.Equal(t, quality.Confidence, 0.0)
+
+	now := time.Now()
+	whoisInfo := WhoisInfo{
+		Domain: &Domain{
+			Domain:               "example.de",
+			Punycode:             "example.de",
+			Name:                 "example",
+			Extension:            "de",
+			Status:               []string{"connect"},
+			NameServers:          []string{"ns1.example.de"},
+			UpdatedDate:          "2020-01-02T03:04:05+01:00",
+			UpdatedDateInTime:    &now,
+			ExpirationDate:       "someday",
+			ExpirationDateInTime: nil,
+		},
+	}
+
+	quality = whoisInfo.Quality()
+	assert.Equal(t, quality.Missing, []Field{})
+	assert.Equal(t, quality.InvalidDates, []Field{FieldDomainExpirationDate})
+	assert.Equal(t, quality.Placeholders, []Field{})
+	assert.Equal(t, quality.Confidence, 0.9)
+
+	whoisInfo.Domain.Extension = "com"
+	whoisInfo.Domain.Punycode = "example.com"
+	whoisInfo.Registrant = &Contact{
+		Name:   "REDACTED FOR PRIVACY",
+		Street: "Registrant Street:",
+		City:   "N/A",
+		Email:  "someone@example.com",
+	}
+
+	quality = whoisInfo.Quality()
+	assert.Equal(t, quality.Missing, []Field{
+		FieldDomainID,
+		FieldDomainWhoisServer,
+		FieldDomainCreatedDate,
+		FieldRegistrarID,
+		FieldRegistrarName,
+		FieldRegistrarReferralURL,
+	})
+	assert.Equal(t, quality.Placeholders, []Field{"registrant.name", "registrant.street", "registrant.city"})
+	assert.True(t, quality.Confidence > 0.23 && quality.Confidence < 0.25)
+}
+
+func TestQualityCorpus(t *testing.T) {
+	whoisRaw, err := xfile.ReadText(noterrorDir + "/com_rockcreekcc.com")
+	assert.Nil(t, err)
+
+	whoisInfo, err := Parse(whoisRaw)
+	assert.Nil(t, err)
+
+	quality := whoisInfo.Quality()
+	assert.Equal(t, quality.Missing, []Field{})
+	assert.Equal(t, quality.InvalidDates, []Field{})
+	assert.Contains(t, quality.Placeholders, Field("registrant.name"))
+	assert.NotContains(t, quality.Placeholders, Field("registrant.province"))
+	assert.Equal(t, quality.Confidence, 1-maxPlaceholderPenalty)
+
+	whoisRaw, err = xfile.ReadText(noterrorDir + "/google_google")
+	assert.Nil(t, err)
+
+	whoisInfo, err = Parse(whoisRaw)
+	assert.Nil(t, err)
+
+	quality = whoisInfo.Quality()
+	assert.Equal(t, quality.Missing, []Field{})
+	assert.Equal(t, quality.InvalidDates, []Field{})
+	assert.Equal(t, quality.Confidence, 1.0)
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimitError is returned if whois query is limited, it wraps ErrDomainLimitExceed
+type RateLimitError struct {
+	// RetryAfter is the time to wait before querying again, 0 if not stated
+	RetryAfter time.Duration
+	// Window is the time window of the query quota, 0 if not stated
+	Window time.Duration
+	// Quota is the number of queries allowed in the window, 0 if not stated
+	Quota int
+	// Server is the whois server responded, empty if not known
+	Server string
+	// Message is the line of whois data stating the limit
+	Message string
+}
+
+var (
+	// rateUnits is the duration of time units
+	rateUnits = map[string]time.Duration{
+		"s":      time.Second,
+		"sec":    time.Second,
+		"second": time.Second,
+		"m":      time.Minute,
+		"min":    time.Minute,
+		"minute": time.Minute,
+		"h":      time.Hour,
+		"hr":     time.Hour,
+		"hour":   time.Hour,
+		"d":      24 * time.Hour,
+		"day":    24 * time.Hour,
+	}
+
+	// rateWindows is the windows of periodic quota words
+	rateWindows = map[string]time.Duration{
+		"hourly": time.Hour,
+		"daily":  24 * time.Hour,
+	}
+)
+
+var (
+	retryAfterRx = regexp.MustCompile(`(?i)(?:try again|retry|wait|blocked|banned|locked|denied|suspended)` +
+		`[^.\n]*?\b(?:in|for|after)\s+(\d+)\s*(s|secs?|seconds?|m|mins?|minutes?|h|hrs?|hours?|d|days?)\b`)
+	retryAfterHeaderRx = regexp.MustCompile(`(?im)^[ \t]*retry-after:[ \t]*(\d+)[ \t]*$`)
+	rateQuotaRx        = regexp.MustCompile(`(?i)(\d+)\s+(?:queries|requests|lookups|connections)\s+` +
+		`(?:per|a|an|each|every|in)\s+(?:(?:a|an|one)\s+)?(\d+\s*)?(seconds?|minutes?|hours?|days?)\b`)
+	rateWindowRx = regexp.MustCompile(`(?i)\b(hourly|daily)\b`)
+)
+
+// Error returns the error message
+func (e *RateLimitError) Error() string {
+	result := ErrDomainLimitExceed.Error()
+
+	details := []string{}
+	if e.Server != "" {
+		details = append(details, "server "+e.Server)
+	}
+	if e.RetryAfter > 0 {
+		details = append(details, "retry after "+e.RetryAfter.String())
+	}
+	if e.Quota > 0 {
+		details = append(details, fmt.Sprintf("quota %d per %s", e.Quota, e.Window))
+	} else if e.Window > 0 {
+		details = append(details, "window "+e.Window.String())
+	}
+
+	if len(details) > 0 {
+		result += ": " + strings.Join(details, ", ")
+	}
+
+	return result
+}
+
+// Unwrap returns ErrDomainLimitExceed
+func (e *RateLimitError) Unwrap() error {
+	return ErrDomainLimitExceed
+}
+
+// newRateLimitError returns rate limit error with details parsed from whois data
+func newRateLimitError(data string) *RateLimitError {
+	e := &RateLimitError{}
+
+	if m := chainHeaderRx.FindStringSubmatch(data); m != nil {
+		e.Server = normalizeServer(m[1] + m[2])
+	}
+
+	for _, v := range strings.Split(data, "\n") {
+		if v = strings.TrimSpace(v); isLimitExceeded(v) {
+			e.Message = v
+			break
+		}
+	}
+
+	if e.Message == "" {
+		e.Message = strings.TrimSpace(data)
+		if pos := strings.Index(e.Message, "\n"); pos != -1 {
+			e.Message = strings.TrimSpace(e.Message[:pos])
+		}
+	}
+
+	if m := retryAfterHeaderRx.FindStringSubmatch(data); m != nil {
+		e.RetryAfter = parseRateDuration(m[1], "s")
+	} else if m := retryAfterRx.FindStringSubmatch(data); m != nil {
+		e.RetryAfter = parseRateDuration(m[1], m[2])
+	}
+
+	if m := rateQuotaRx.FindStringSubmatch(data); m != nil {
+		e.Quota, _ = strconv.Atoi(m[1])
+		count := strings.TrimSpace(m[2])
+		if count == "" {
+			count = "1"
+		}
+		e.Window = parseRateDuration(count, m[3])
+	} else if m := rateWindowRx.FindStringSubmatch(data); m != nil {
+		e.Window = rateWindows[strings.ToLower(m[1])]
+	}
+
+	return e
+}
+
+// parseRateDuration returns duration of count and time unit, e.g. "60" and "seconds"
+func parseRateDuration(count, unit string) time.Duration {
+	n, err := strconv.Atoi(count)
+	if err != nil {
+		return 0
+	}
+
+	unit = strings.ToLower(unit)
+	if _, ok := rateUnits[unit]; !ok {
+		unit = strings.TrimSuffix(unit, "s")
+	}
+
+	return time.Duration(n) * rateUnits[unit]
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/likexian/gokit/assert"
+)
+
+func TestRateLimitError(t *testing.T) {
+	tests := []struct {
+		data string
+		exp  RateLimitError
+	}{
+		{
+			"WHOIS LIMIT EXCEEDED - SEE WWW.PIR.ORG/WHOIS FOR DETAILS",
+			RateLimitError{
+				Message: "WHOIS LIMIT EXCEEDED - SEE WWW.PIR.ORG/WHOIS FOR DETAILS",
+			},
+		},
+		{
+			"[whois.nic.example]\nQuery rate limit exceeded, please try again in 60 seconds.",
+			RateLimitError{
+				RetryAfter: time.Minute,
+				Server:     "whois.nic.example",
+				Message:    "Query rate limit exceeded, please try again in 60 seconds.",
+			},
+		},
+		{
+			"Your IP has been blocked for 1 hour.\nMaximum query rate reached: 100 queries per hour allowed.",
+			RateLimitError{
+				RetryAfter: time.Hour,
+				Window:     time.Hour,
+				Quota:      100,
+				Message:    "Maximum query rate reached: 100 queries per hour allowed.",
+			},
+		},
+		{
+			"Daily quota exceeded for your IP address.\nRetry-After: 3600",
+			RateLimitError{
+				RetryAfter: time.Hour,
+				Window:     24 * time.Hour,
+				Message:    "Daily quota exceeded for your IP address.",
+			},
+		},
+		{
+			"Too many requests, 50 queries in 10 minutes are allowed, wait for 5 mins",
+			RateLimitError{
+				RetryAfter: 5 * time.Minute,
+				Window:     10 * time.Minute,
+				Quota:      50,
+				Message:    "Too many requests, 50 queries in 10 minutes are allowed, wait for 5 mins",
+			},
+		},
+	}
+
+	for _, v := range tests {
+		_, err := Parse(v.data)
+		assert.True(t, errors.Is(err, ErrDomainLimitExceed), v.data)
+
+		var e *RateLimitError
+		assert.True(t, errors.As(err, &e), v.data)
+		assert.Equal(t, *e, v.exp, v.data)
+	}
+
+	err := &RateLimitError{}
+	assert.Equal(t, err.Error(), "whoisparser: domain whois query limit exceeded")
+
+	err = &RateLimitError{
+		RetryAfter: time.Minute,
+		Window:     time.Hour,
+		Quota:      100,
+		Server:     "whois.nic.example",
+	}
+	assert.Equal(t, err.Error(), "whoisparser: domain whois query limit exceeded: "+
+		"server whois.nic.example, retry after 1m0s, quota 100 per 1h0m0s")
+}
+
+func TestParseChainRateLimit(t *testing.T) {
+	chainInfo, err := ParseChain("[whois.verisign-grs.com]\nDomain Name: GOOGLE.COM\n" +
+		"Registry Domain ID: 2138514_DOMAIN_COM-VRSN\n" +
+		"Registrar WHOIS Server: whois.markmonitor.com\n" +
+		"Registrar: MarkMonitor Inc.\nRegistrar IANA ID: 292\n" +
+		"Creation Date: 1997-09-15T04:00:00Z\n" +
+		"Name Server: NS1.GOOGLE.COM\n" +
+		">>> Last update of whois database: 2019-09-30T14:21:56Z <<<\n" +
+		"[whois.markmonitor.com]\nQuota exceeded, try again after 30 seconds")
+	assert.Nil(t, err)
+	assert.Equal(t, len(chainInfo.Hops), 2)
+
+	var e *RateLimitError
+	assert.True(t, errors.As(chainInfo.Hops[1].Err, &e))
+	assert.Equal(t, e.Server, "whois.markmonitor.com")
+	assert.Equal(t, e.RetryAfter, 30*time.Second)
+}
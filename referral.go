@@ -0,0 +1,148 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// referralRule is the referral key mapper, lower value is preferred
+	referralRule = map[string]int{
+		"refer":                  1,
+		"referralserver":         2,
+		"referral server":        2,
+		"registrar whois server": 3,
+		"whois server":           4,
+		"whois":                  5,
+	}
+
+	// rirServers is the whois servers of regional internet registries
+	rirServers = map[string]string{
+		"afrinic":  "whois.afrinic.net",
+		"apnic":    "whois.apnic.net",
+		"arin":     "whois.arin.net",
+		"lacnic":   "whois.lacnic.net",
+		"ripe ncc": "whois.ripe.net",
+	}
+
+	// schemePorts is the default port of referral schemes
+	schemePorts = map[string]string{
+		"whois":  "43",
+		"rwhois": "4321",
+	}
+)
+
+var referralHostRx = regexp.MustCompile(`^[a-z0-9]([a-z0-9\-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9\-]*[a-z0-9])?)+$`)
+
+// NextReferral returns the whois server to query next from the whois information,
+// chain is the servers queried so far, ok is false if there is no referral or the
+// referral loops back to a server in the chain. The server is normalized to a
+// lowercase host, with port appended only if it is not the default whois port.
+func NextReferral(text string, chain ...string) (server string, ok bool) {
+	priority := 0
+
+	for _, v := range strings.Split(text, "\n") {
+		v = strings.TrimSpace(v)
+		if !strings.Contains(v, ":") {
+			continue
+		}
+
+		vs := strings.SplitN(v, ":", 2)
+		name := clearKeyName(vs[0])
+		value := strings.TrimSpace(vs[1])
+		if value == "" {
+			continue
+		}
+
+		found := ""
+		p, isReferral := referralRule[name]
+		if isReferral {
+			found = normalizeServer(value)
+		} else if name == "nettype" {
+			found, p = searchRIRServer(value), len(referralRule)+1
+		}
+
+		if found != "" && (priority == 0 || p < priority) {
+			server, priority = found, p
+		}
+	}
+
+	if server == "" {
+		return "", false
+	}
+
+	for _, v := range chain {
+		if normalizeServer(v) == server {
+			return "", false
+		}
+	}
+
+	return server, true
+}
+
+// normalizeServer returns normalized whois server host and port
+func normalizeServer(server string) string {
+	server = strings.ToLower(strings.TrimSpace(server))
+
+	port := ""
+	if pos := strings.Index(server, "://"); pos != -1 {
+		port = schemePorts[server[:pos]]
+		server = server[pos+3:]
+	}
+
+	if pos := strings.IndexAny(server, "/ "); pos != -1 {
+		server = server[:pos]
+	}
+
+	if pos := strings.LastIndex(server, ":"); pos != -1 {
+		port = server[pos+1:]
+		server = server[:pos]
+	}
+
+	server = strings.TrimSuffix(server, ".")
+	if !referralHostRx.MatchString(server) {
+		return ""
+	}
+
+	if port != "" && port != schemePorts["whois"] {
+		server += ":" + port
+	}
+
+	return server
+}
+
+// searchRIRServer returns whois server of regional internet registry
+// from allocation, e.g. "Allocated to RIPE NCC"
+func searchRIRServer(value string) string {
+	value = strings.ToLower(value)
+	if !strings.HasPrefix(value, "allocated to ") && !strings.HasPrefix(value, "transferred to ") {
+		return ""
+	}
+
+	for _, k := range keys(rirServers) {
+		if strings.Contains(value, k) {
+			return rirServers[k]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"testing"
+
+	"github.com/likexian/gokit/assert"
+	"github.com/likexian/gokit/xfile"
+)
+
+func TestNextReferral(t *testing.T) {
+	tests := []struct {
+		whois  string
+		server string
+		ok     bool
+	}{
+		{"Registrar WHOIS Server: whois.markmonitor.com\n", "whois.markmonitor.com", true},
+		{"Registrar WHOIS Server: WHOIS.MARKMONITOR.COM.\n", "whois.markmonitor.com", true},
+		{"Registrar WHOIS Server: http://whois.godaddy.com/\n", "whois.godaddy.com", true},
+		{"Registrar WHOIS Server:\nDomain Name: example.com\n", "", false},
+		{"Whois Server: whois.nic.uk\n", "whois.nic.uk", true},
+		{"refer:        whois.verisign-grs.com\n\ndomain:       COM\n", "whois.verisign-grs.com", true},
+		{"ReferralServer:  whois://whois.ripe.net\n", "whois.ripe.net", true},
+		{"ReferralServer:  whois://whois.ripe.net:43\n", "whois.ripe.net", true},
+		{"ReferralServer:  rwhois://rwhois.example.net\n", "rwhois.example.net:4321", true},
+		{"ReferralServer:  rwhois://rwhois.example.net:4322\n", "rwhois.example.net:4322", true},
+		{"NetType:        Allocated to RIPE NCC\n", "whois.ripe.net", true},
+		{"NetType:        Allocated to APNIC\n", "whois.apnic.net", true},
+		{"NetType:        Direct Allocation\n", "", false},
+		{"Registrar WHOIS Server: not available\n", "", false},
+		{"whois: whois.nic.google\nrefer: whois.iana.org\n", "whois.iana.org", true},
+	}
+
+	for _, v := range tests {
+		server, ok := NextReferral(v.whois)
+		assert.Equal(t, server, v.server, v.whois)
+		assert.Equal(t, ok, v.ok, v.whois)
+	}
+
+	server, ok := NextReferral("Registrar WHOIS Server: whois.tucows.com", "whois.verisign-grs.com", "WHOIS.TUCOWS.COM:43")
+	assert.Equal(t, server, "")
+	assert.False(t, ok)
+
+	server, ok = NextReferral("Registrar WHOIS Server: whois.tucows.com", "whois.verisign-grs.com")
+	assert.Equal(t, server, "whois.tucows.com")
+	assert.True(t, ok)
+
+	whoisRaw, err := xfile.ReadText(noterrorDir + "/com_com")
+	assert.Nil(t, err)
+	server, ok = NextReferral(whoisRaw, "whois.iana.org")
+	assert.Equal(t, server, "whois.verisign-grs.com")
+	assert.True(t, ok)
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"strings"
+
+	"github.com/likexian/gokit/assert"
+)
+
+var (
+	// registrarKeyRule is the key rule mapper for registrar object
+	registrarKeyRule = map[string]string{
+		"registrar":              "registrar_name",
+		"registrar name":         "registrar_name",
+		"iana id":                "registrar_id",
+		"registrar iana id":      "registrar_id",
+		"whois server":           "whois_server",
+		"referral url":           "referral_url",
+		"registrar url":          "referral_url",
+		"url":                    "referral_url",
+		"admin contact":          "admin_contact",
+		"administrative contact": "admin_contact",
+		"technical contact":      "technical_contact",
+		"tech contact":           "technical_contact",
+	}
+)
+
+// ParseRegistrar returns parsed registrar object whois info
+func ParseRegistrar(text string) (registrarInfo RegistrarInfo, err error) {
+	registrar := &Contact{}
+	contact := registrar
+
+	text = strings.ReplaceAll(text, "\r", "")
+	for _, v := range strings.Split(text, "\n") {
+		v = strings.TrimSpace(v)
+		if len(v) < 5 || !strings.Contains(v, ":") {
+			continue
+		}
+
+		if assert.IsContains([]string{"-", "*", "%", ">", ";"}, v[:1]) {
+			continue
+		}
+
+		vs := strings.SplitN(v, ":", 2)
+		name := clearKeyName(vs[0])
+		value := strings.TrimSpace(vs[1])
+		if value == "" {
+			continue
+		}
+
+		keyName := registrarKeyRule[name]
+
+		// multiple registrar objects may be returned, only the first is parsed
+		if keyName == "registrar_name" && registrar.Name != "" {
+			break
+		}
+
+		switch keyName {
+		case "registrar_name":
+			registrar.Name = value
+		case "registrar_id":
+			registrar.ID = value
+		case "whois_server":
+			registrarInfo.WhoisServer = value
+		case "referral_url":
+			registrar.ReferralURL = value
+		case "admin_contact":
+			contact = &Contact{Name: value}
+			registrarInfo.Administrative = append(registrarInfo.Administrative, contact)
+		case "technical_contact":
+			contact = &Contact{Name: value}
+			registrarInfo.Technical = append(registrarInfo.Technical, contact)
+		default:
+			parseContact(contact, "registrant "+name, value)
+		}
+	}
+
+	if registrar.Name == "" {
+		err = getDomainErrorType(text)
+		return
+	}
+
+	registrarInfo.Registrar = registrar
+
+	return
+}
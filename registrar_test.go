@@ -0,0 +1,81 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"testing"
+
+	"github.com/likexian/gokit/assert"
+)
+
+func TestParseRegistrar(t *testing.T) {
+	// whois.verisign-grs.com "registrar MarkMonitor Inc."
+	data := `Registrar Name: MarkMonitor Inc.
+   Address: 3540 East Longwing Lane, Suite 300, Meridian, ID 83646, US
+   Phone Number: +1.2083895740
+   Email: ccops@markmonitor.com
+   Whois Server: whois.markmonitor.com
+   Referral URL: www.markmonitor.com
+   Admin Contact: Domain Operations
+   Phone Number: +1.2083895740
+   Email: CCOPS@markmonitor.com
+   Admin Contact: Legal Department
+   Phone Number: +1.2083895741
+   Technical Contact: Domain Operations
+   Phone Number: +1.2083895740
+   Email: ccops@markmonitor.com
+>>> Last update of whois database: 2024-05-20T08:21:27Z <<<`
+
+	registrarInfo, err := ParseRegistrar(data)
+	assert.Nil(t, err)
+	assert.Equal(t, registrarInfo.Registrar, &Contact{
+		Name:        "MarkMonitor Inc.",
+		Street:      "3540 East Longwing Lane, Suite 300, Meridian, ID 83646, US",
+		Phone:       "+1.2083895740",
+		Email:       "ccops@markmonitor.com",
+		ReferralURL: "www.markmonitor.com",
+	})
+	assert.Equal(t, registrarInfo.WhoisServer, "whois.markmonitor.com")
+	assert.Equal(t, registrarInfo.Administrative, []*Contact{
+		{Name: "Domain Operations", Phone: "+1.2083895740", Email: "ccops@markmonitor.com"},
+		{Name: "Legal Department", Phone: "+1.2083895741"},
+	})
+	assert.Equal(t, registrarInfo.Technical, []*Contact{
+		{Name: "Domain Operations", Phone: "+1.2083895740", Email: "ccops@markmonitor.com"},
+	})
+
+	// multiple registrar objects, only the first is parsed
+	data = `Registrar Name: Example Registrar, Inc.
+Registrar IANA ID: 9999
+Whois Server: whois.example-registrar.com
+
+Registrar Name: Example Registrar Europe Ltd.
+Registrar IANA ID: 9998
+Whois Server: whois.example-registrar.eu`
+
+	registrarInfo, err = ParseRegistrar(data)
+	assert.Nil(t, err)
+	assert.Equal(t, registrarInfo.Registrar.Name, "Example Registrar, Inc.")
+	assert.Equal(t, registrarInfo.Registrar.ID, "9999")
+	assert.Equal(t, registrarInfo.WhoisServer, "whois.example-registrar.com")
+
+	_, err = ParseRegistrar(`No match for "LIKEXIAN NO MONEY INC".`)
+	assert.Equal(t, err, ErrNotFoundDomain)
+}
@@ -22,70 +22,154 @@ package whoisparser
 var (
 	// keyRule is the key rule mapper for parser
 	keyRule = map[string]string{
-		"id":                                     "domain_id",
-		"roid":                                   "domain_id",
-		"domain id":                              "domain_id",
-		"domain":                                 "domain_name",
-		"domain name":                            "domain_name",
-		"status":                                 "domain_status",
-		"state":                                  "domain_status",
-		"domain status":                          "domain_status",
-		"registration status":                    "domain_status",
-		"query status":                           "domain_status",
-		"dnssec":                                 "domain_dnssec",
-		"domain dnssec":                          "domain_dnssec",
-		"registrar dnssec":                       "domain_dnssec",
-		"signing key":                            "domain_dnssec",
-		"domain signed":                          "domain_dnssec",
-		"whois":                                  "whois_server",
-		"whois server":                           "whois_server",
-		"registrar whois server":                 "whois_server",
-		"dns":                                    "name_servers",
-		"nserver":                                "name_servers",
-		"name server":                            "name_servers",
-		"name servers":                           "name_servers",
-		"nameserver":                             "name_servers",
-		"nameservers":                            "name_servers",
-		"name servers information":               "name_servers",
-		"host name":                              "name_servers",
-		"hostname":                               "name_servers",
-		"domain nameservers":                     "name_servers",
-		"domain name servers":                    "name_servers",
-		"domain servers in listed order":         "name_servers",
-		"created":                                "created_date",
-		"registered":                             "created_date",
-		"created on":                             "created_date",
-		"create date":                            "created_date",
-		"created date":                           "created_date",
-		"creation date":                          "created_date",
-		"domain registration date":               "created_date",
-		"registration date":                      "created_date",
-		"domain create date":                     "created_date",
-		"domain created":                         "created_date",
-		"domain name commencement date":          "created_date",
-		"registered date":                        "created_date",
-		"registered on":                          "created_date",
-		"registration time":                      "created_date",
-		"first registration date":                "created_date",
-		"domain record activated":                "created_date",
-		"record created":                         "created_date",
-		"record created on":                      "created_date",
-		"domain registered":                      "created_date",
-		"modified":                               "updated_date",
-		"changed":                                "updated_date",
-		"updated":                                "updated_date",
-		"update date":                            "updated_date",
-		"updated date":                           "updated_date",
-		"updated on":                             "updated_date",
-		"last update":                            "updated_date",
-		"last updated":                           "updated_date",
-		"last updated on":                        "updated_date",
-		"last modified":                          "updated_date",
-		"last updated date":                      "updated_date",
-		"domain last updated date":               "updated_date",
-		"domain record last updated":             "updated_date",
-		"domain datelastmodified":                "updated_date",
-		"modification date":                      "updated_date",
+		"id":                             "domain_id",
+		"roid":                           "domain_id",
+		"domain id":                      "domain_id",
+		"id du domaine":                  "domain_id",
+		"domain":                         "domain_name",
+		"domain name":                    "domain_name",
+		"域名":                             "domain_name",
+		"域名名称":                           "domain_name",
+		"域名名稱":                           "domain_name",
+		"домен":                          "domain_name",
+		"доменное имя":                   "domain_name",
+		"имя домена":                     "domain_name",
+		"домен атауы":                    "domain_name",
+		"dominio":                        "domain_name",
+		"nombre de dominio":              "domain_name",
+		"domínio":                        "domain_name",
+		"nome de domínio":                "domain_name",
+		"domaine":                        "domain_name",
+		"nom de domaine":                 "domain_name",
+		"status":                         "domain_status",
+		"state":                          "domain_status",
+		"domain status":                  "domain_status",
+		"registration status":            "domain_status",
+		"query status":                   "domain_status",
+		"域名状态":                           "domain_status",
+		"域名狀態":                           "domain_status",
+		"статус":                         "domain_status",
+		"состояние":                      "domain_status",
+		"статус домена":                  "domain_status",
+		"стан":                           "domain_status",
+		"estado del dominio":             "domain_status",
+		"situação":                       "domain_status",
+		"statut":                         "domain_status",
+		"état":                           "domain_status",
+		"statut du domaine":              "domain_status",
+		"dnssec":                         "domain_dnssec",
+		"domain dnssec":                  "domain_dnssec",
+		"registrar dnssec":               "domain_dnssec",
+		"signing key":                    "domain_dnssec",
+		"domain signed":                  "domain_dnssec",
+		"whois":                          "whois_server",
+		"whois server":                   "whois_server",
+		"registrar whois server":         "whois_server",
+		"serveur whois":                  "whois_server",
+		"dns":                            "name_servers",
+		"nserver":                        "name_servers",
+		"name server":                    "name_servers",
+		"name servers":                   "name_servers",
+		"nameserver":                     "name_servers",
+		"nameservers":                    "name_servers",
+		"name servers information":       "name_servers",
+		"host name":                      "name_servers",
+		"hostname":                       "name_servers",
+		"domain nameservers":             "name_servers",
+		"domain name servers":            "name_servers",
+		"domain servers in listed order": "name_servers",
+		"域名服务器":                          "name_servers",
+		"域名伺服器":                          "name_servers",
+		"名称服务器":                          "name_servers",
+		"名稱伺服器":                          "name_servers",
+		"серверы dns":                    "name_servers",
+		"сервер dns":                     "name_servers",
+		"сервер имен":                    "name_servers",
+		"сервери dns":                    "name_servers",
+		"сервер імен":                    "name_servers",
+		"servidor de nombres":            "name_servers",
+		"servidores de nombres":          "name_servers",
+		"servidor de nombre":             "name_servers",
+		"servidor dns":                   "name_servers",
+		"servidores dns":                 "name_servers",
+		"servidor de nomes":              "name_servers",
+		"servidores de nomes":            "name_servers",
+		"serveur de noms":                "name_servers",
+		"serveurs de noms":               "name_servers",
+		"serveur dns":                    "name_servers",
+		"created":                        "created_date",
+		"registered":                     "created_date",
+		"created on":                     "created_date",
+		"create date":                    "created_date",
+		"created date":                   "created_date",
+		"creation date":                  "created_date",
+		"domain registration date":       "created_date",
+		"registration date":              "created_date",
+		"domain create date":             "created_date",
+		"domain created":                 "created_date",
+		"domain name commencement date":  "created_date",
+		"registered date":                "created_date",
+		"registered on":                  "created_date",
+		"registration time":              "created_date",
+		"first registration date":        "created_date",
+		"domain record activated":        "created_date",
+		"record created":                 "created_date",
+		"record created on":              "created_date",
+		"domain registered":              "created_date",
+		"注册日期":                           "created_date",
+		"註冊日期":                           "created_date",
+		"注册时间":                           "created_date",
+		"註冊時間":                           "created_date",
+		"创建日期":                           "created_date",
+		"建立日期":                           "created_date",
+		"дата регистрации":               "created_date",
+		"дата создания":                  "created_date",
+		"домен создан":                   "created_date",
+		"дата реєстрації":                "created_date",
+		"створено":                       "created_date",
+		"fecha de registro":              "created_date",
+		"fecha de creación":              "created_date",
+		"fecha de alta":                  "created_date",
+		"data de registro":               "created_date",
+		"data de criação":                "created_date",
+		"criado":                         "created_date",
+		"date de création":               "created_date",
+		"date d enregistrement":          "created_date",
+		"créé le":                        "created_date",
+		"modified":                       "updated_date",
+		"changed":                        "updated_date",
+		"updated":                        "updated_date",
+		"update date":                    "updated_date",
+		"updated date":                   "updated_date",
+		"updated on":                     "updated_date",
+		"last update":                    "updated_date",
+		"last updated":                   "updated_date",
+		"last updated on":                "updated_date",
+		"last modified":                  "updated_date",
+		"last updated date":              "updated_date",
+		"domain last updated date":       "updated_date",
+		"domain record last updated":     "updated_date",
+		"domain datelastmodified":        "updated_date",
+		"modification date":              "updated_date",
+		"更新日期":                           "updated_date",
+		"更新时间":                           "updated_date",
+		"更新時間":                           "updated_date",
+		"最后更新":                           "updated_date",
+		"最後更新":                           "updated_date",
+		"дата изменения":                 "updated_date",
+		"дата обновления":                "updated_date",
+		"последнее изменение":            "updated_date",
+		"последнее обновление": "updated_date",
+		"змінено":                                "updated_date",
+		"fecha de actualización":                 "updated_date",
+		"fecha de modificación":                  "updated_date",
+		"última actualización":                   "updated_date",
+		"data de atualização":                    "updated_date",
+		"última atualização":                     "updated_date",
+		"alterado":                               "updated_date",
+		"date de modification":                   "updated_date",
+		"dernière modification":                  "updated_date",
+		"date de mise à jour":                    "updated_date",
 		"expire":                                 "expired_date",
 		"expires":                                "expired_date",
 		"expires on":                             "expired_date",
@@ -104,12 +188,39 @@ var (
 		"domain expires":                         "expired_date",
 		"record expires on":                      "expired_date",
 		"record will expire on":                  "expired_date",
+		"过期日期":                                   "expired_date",
+		"過期日期":                                   "expired_date",
+		"过期时间":                                   "expired_date",
+		"過期時間":                                   "expired_date",
+		"到期日期":                                   "expired_date",
+		"到期时间":                                   "expired_date",
+		"到期時間":                                   "expired_date",
+		"оплачен до":                             "expired_date",
+		"дата окончания":                         "expired_date",
+		"действует до":                           "expired_date",
+		"оплачено до":                            "expired_date",
+		"дата закінчення":                        "expired_date",
+		"закінчується":                           "expired_date",
+		"fecha de vencimiento":                   "expired_date",
+		"fecha de expiración":                    "expired_date",
+		"vencimiento":                            "expired_date",
+		"data de expiração":                      "expired_date",
+		"data de vencimento":                     "expired_date",
+		"expiração":                              "expired_date",
+		"date d expiration":                      "expired_date",
+		"date d échéance":                        "expired_date",
+		"expire le":                              "expired_date",
 		"referral url":                           "referral_url",
 		"registrar url":                          "referral_url",
 		"registrar www":                          "referral_url",
 		"registrar web":                          "referral_url",
 		"registrar website":                      "referral_url",
 		"registration service url":               "referral_url",
+		"注册商网址":                                  "referral_url",
+		"註冊商網址":                                  "referral_url",
+		"url del registrador":                    "referral_url",
+		"url do registrador":                     "referral_url",
+		"url du bureau d enregistrement":         "referral_url",
 		"registrant c":                           "registrant_id",
 		"registrant id":                          "registrant_id",
 		"registrant iana id":                     "registrant_id",
@@ -191,4 +302,102 @@ var (
 		"registrant contact e mail":              "registrant_email",
 		"registrant abuse contact email":         "registrant_email",
 	}
+
+	// keyNameRule is the english key names of localized key names, which have
+	// the role after the field such as "nombre del titular"
+	keyNameRule = map[string]string{
+		"titular":                        "registrant name",
+		"nombre del titular":             "registrant name",
+		"registrante":                    "registrant name",
+		"organización del titular":       "registrant organization",
+		"dirección del titular":          "registrant street",
+		"ciudad del titular":             "registrant city",
+		"país del titular":               "registrant country",
+		"teléfono del titular":           "registrant phone",
+		"correo del titular":             "registrant email",
+		"correo electrónico del titular": "registrant email",
+		"responsável":                    "registrant name",
+		"titulaire":                      "registrant name",
+		"propriétaire":                   "registrant name",
+		"nom du titulaire":               "registrant name",
+		"organisation du titulaire":      "registrant organization",
+		"adresse du titulaire":           "registrant street",
+		"courriel du titulaire":          "registrant email",
+		"registrador":                    "registrar name",
+		"agente registrador":             "registrar name",
+		"nombre del registrador":         "registrar name",
+		"bureau d enregistrement":        "registrar name",
+		"registraire":                    "registrar name",
+		"contacto administrativo":        "admin name",
+		"contato administrativo":         "admin name",
+		"contact administratif":          "admin name",
+		"contacto técnico":               "tech name",
+		"contato técnico":                "tech name",
+		"contact technique":              "tech name",
+	}
+
+	// extKeyNameRule is the english key names of localized key names by extension,
+	// which are scoped since the same word has other meanings in other languages,
+	// the danish labels are not here since prepareDK replaces them before parsing
+	extKeyNameRule = map[string]map[string]string{
+		"de": germanKeyNameRule,
+		"at": germanKeyNameRule,
+		"ch": germanKeyNameRule,
+		"nl": dutchKeyNameRule,
+		"be": dutchKeyNameRule,
+		"no": norwegianKeyNameRule,
+		"se": swedishKeyNameRule,
+		"nu": swedishKeyNameRule,
+		"br": portugueseKeyNameRule,
+	}
+
+	// portugueseKeyNameRule is the english key names of portuguese key names
+	portugueseKeyNameRule = map[string]string{
+		"documento": "registrant id",
+	}
+
+	// germanKeyNameRule is the english key names of german key names
+	germanKeyNameRule = map[string]string{
+		"domäne":                  "domain",
+		"inhaber":                 "registrant name",
+		"domaininhaber":           "registrant name",
+		"erstellt":                "created",
+		"registriert":             "registered",
+		"erstregistrierungsdatum": "first registration date",
+		"geändert":                "changed",
+		"zuletzt geändert":        "changed",
+		"ablaufdatum":             "expires",
+		"nameserver":              "nserver",
+	}
+
+	// dutchKeyNameRule is the english key names of dutch key names
+	dutchKeyNameRule = map[string]string{
+		"domeinnaam":        "domain name",
+		"houder":            "registrant name",
+		"registratiedatum":  "creation date",
+		"laatst gewijzigd":  "updated date",
+		"domeinnaamservers": "domain nameservers",
+		"naamservers":       "name servers",
+	}
+
+	// norwegianKeyNameRule is the english key names of norwegian key names
+	norwegianKeyNameRule = map[string]string{
+		"domenenavn":      "domain name",
+		"registrant navn": "registrant name",
+		"opprettet":       "created",
+		"sist oppdatert":  "updated date",
+		"navnetjener":     "name server",
+	}
+
+	// swedishKeyNameRule is the english key names of swedish key names
+	swedishKeyNameRule = map[string]string{
+		"domän":       "domain",
+		"tillstånd":   "state",
+		"innehavare":  "holder",
+		"skapad":      "created",
+		"ändrad":      "modified",
+		"utgår":       "expires",
+		"namnserver":  "nserver",
+		"registrator": "registrar",
+	}
 )
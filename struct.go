@@ -67,3 +67,60 @@ type Contact struct {
 	Email        string `json:"email,omitempty"`
 	ReferralURL  string `json:"referral_url,omitempty"`
 }
+
+// TLDInfo storing tld whois info from IANA root zone
+type TLDInfo struct {
+	TLD               string          `json:"tld,omitempty"`
+	Punycode          string          `json:"punycode,omitempty"`
+	Refer             string          `json:"refer,omitempty"`
+	Organisation      *Contact        `json:"organisation,omitempty"`
+	Administrative    *Contact        `json:"administrative,omitempty"`
+	Technical         *Contact        `json:"technical,omitempty"`
+	NameServers       []TLDNameServer `json:"name_servers,omitempty"`
+	DSRecords         []DSRecord      `json:"ds_records,omitempty"`
+	WhoisServer       string          `json:"whois_server,omitempty"`
+	Status            string          `json:"status,omitempty"`
+	Remarks           []string        `json:"remarks,omitempty"`
+	CreatedDate       string          `json:"created_date,omitempty"`
+	CreatedDateInTime *time.Time      `json:"created_date_in_time,omitempty"`
+	UpdatedDate       string          `json:"updated_date,omitempty"`
+	UpdatedDateInTime *time.Time      `json:"updated_date_in_time,omitempty"`
+	Source            string          `json:"source,omitempty"`
+}
+
+// TLDNameServer storing tld name server with glue addresses
+type TLDNameServer struct {
+	Name        string   `json:"name,omitempty"`
+	IPAddresses []string `json:"ip_addresses,omitempty"`
+}
+
+// DSRecord storing delegation signer record
+type DSRecord struct {
+	KeyTag     int    `json:"key_tag"`
+	Algorithm  int    `json:"algorithm"`
+	DigestType int    `json:"digest_type"`
+	Digest     string `json:"digest"`
+}
+
+// NameServerInfo storing name server host object whois info
+type NameServerInfo struct {
+	ID                string     `json:"id,omitempty"`
+	Name              string     `json:"name,omitempty"`
+	Punycode          string     `json:"punycode,omitempty"`
+	IPAddresses       []string   `json:"ip_addresses,omitempty"`
+	Status            []string   `json:"status,omitempty"`
+	WhoisServer       string     `json:"whois_server,omitempty"`
+	CreatedDate       string     `json:"created_date,omitempty"`
+	CreatedDateInTime *time.Time `json:"created_date_in_time,omitempty"`
+	UpdatedDate       string     `json:"updated_date,omitempty"`
+	UpdatedDateInTime *time.Time `json:"updated_date_in_time,omitempty"`
+	Registrar         *Contact   `json:"registrar,omitempty"`
+}
+
+// RegistrarInfo storing registrar object whois info
+type RegistrarInfo struct {
+	Registrar      *Contact   `json:"registrar,omitempty"`
+	WhoisServer    string     `json:"whois_server,omitempty"`
+	Administrative []*Contact `json:"administrative,omitempty"`
+	Technical      []*Contact `json:"technical,omitempty"`
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// ParseTLD returns parsed tld whois info from IANA root zone whois
+func ParseTLD(text string) (tldInfo TLDInfo, err error) { //nolint:cyclop
+	token := ""
+	organisation := &Contact{}
+	administrative := &Contact{}
+	technical := &Contact{}
+
+	text = strings.ReplaceAll(text, "\r", "")
+	for _, v := range strings.Split(text, "\n") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			if token == "organisation" {
+				token = ""
+			}
+			continue
+		}
+
+		if v[0] == '%' || !strings.Contains(v, ":") {
+			continue
+		}
+
+		vs := strings.SplitN(v, ":", 2)
+		name := strings.TrimSpace(vs[0])
+		value := strings.TrimSpace(vs[1])
+		if value == "" {
+			continue
+		}
+
+		switch name {
+		case "refer":
+			tldInfo.Refer = strings.ToLower(value)
+		case "domain":
+			tldInfo.TLD = strings.ToLower(value)
+			tldInfo.Punycode, _ = idna.ToASCII(tldInfo.TLD)
+		case "contact":
+			token = strings.ToLower(value)
+		case "nserver":
+			tldInfo.NameServers = append(tldInfo.NameServers, parseTLDNameServer(value))
+		case "ds-rdata":
+			if ds, ok := parseDSRecord(value); ok {
+				tldInfo.DSRecords = append(tldInfo.DSRecords, ds)
+			}
+		case "whois":
+			tldInfo.WhoisServer = strings.ToLower(value)
+		case "status":
+			tldInfo.Status = value
+		case "remarks":
+			tldInfo.Remarks = append(tldInfo.Remarks, value)
+		case "created":
+			tldInfo.CreatedDate = value
+			if parsed, err := parseDateString(value); err == nil {
+				tldInfo.CreatedDateInTime = &parsed
+			}
+		case "changed":
+			tldInfo.UpdatedDate = value
+			if parsed, err := parseDateString(value); err == nil {
+				tldInfo.UpdatedDateInTime = &parsed
+			}
+		case "source":
+			tldInfo.Source = value
+		default:
+			if name == "organisation" && token == "" {
+				token = name
+			}
+			switch token {
+			case "organisation":
+				parseContact(organisation, "registrant "+name, value)
+			case "administrative":
+				parseContact(administrative, "registrant "+name, value)
+			case "technical":
+				parseContact(technical, "registrant "+name, value)
+			}
+		}
+	}
+
+	if tldInfo.TLD == "" {
+		err = getDomainErrorType(text)
+		return
+	}
+
+	if *organisation != (Contact{}) {
+		tldInfo.Organisation = organisation
+	}
+
+	if *administrative != (Contact{}) {
+		tldInfo.Administrative = administrative
+	}
+
+	if *technical != (Contact{}) {
+		tldInfo.Technical = technical
+	}
+
+	return
+}
+
+// parseTLDNameServer returns name server with its glue addresses,
+// e.g. "A.GTLD-SERVERS.NET 192.5.6.30 2001:503:a83e:0:0:0:2:30"
+func parseTLDNameServer(value string) TLDNameServer {
+	fields := strings.Fields(value)
+
+	return TLDNameServer{
+		Name:        strings.ToLower(strings.Trim(fields[0], ".")),
+		IPAddresses: fields[1:],
+	}
+}
+
+// parseDSRecord returns delegation signer record from rdata,
+// e.g. "30909 8 2 E2D3C916F6DEEAC73294E8268FB5885044A833FC5459588F4A9184CFC41A5766"
+func parseDSRecord(value string) (ds DSRecord, ok bool) {
+	fields := strings.Fields(value)
+	if len(fields) < 4 {
+		return
+	}
+
+	var err error
+	if ds.KeyTag, err = strconv.Atoi(fields[0]); err != nil {
+		return
+	}
+	if ds.Algorithm, err = strconv.Atoi(fields[1]); err != nil {
+		return
+	}
+	if ds.DigestType, err = strconv.Atoi(fields[2]); err != nil {
+		return
+	}
+
+	ds.Digest = strings.ToUpper(strings.Join(fields[3:], ""))
+
+	return ds, true
+}
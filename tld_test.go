@@ -0,0 +1,84 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"testing"
+
+	"github.com/likexian/gokit/assert"
+	"github.com/likexian/gokit/xfile"
+)
+
+func TestParseTLD(t *testing.T) {
+	whoisRaw, err := xfile.ReadText(noterrorDir + "/com_com")
+	assert.Nil(t, err)
+
+	tldInfo, err := ParseTLD(whoisRaw)
+	assert.Nil(t, err)
+	assert.Equal(t, tldInfo.TLD, "com")
+	assert.Equal(t, tldInfo.Punycode, "com")
+	assert.Equal(t, tldInfo.Organisation.Organization, "VeriSign Global Registry Services")
+	assert.Equal(t, tldInfo.Organisation.Street, "12061 Bluemont Way, Reston Virginia 20190, United States")
+	assert.Equal(t, tldInfo.Administrative.Name, "Registry Customer Service")
+	assert.Equal(t, tldInfo.Administrative.Fax, "+1 703 948 3978")
+	assert.Equal(t, tldInfo.Technical.Email, "info@verisign-grs.com")
+	assert.Equal(t, len(tldInfo.NameServers), 13)
+	assert.Equal(t, tldInfo.NameServers[0], TLDNameServer{
+		Name:        "a.gtld-servers.net",
+		IPAddresses: []string{"192.5.6.30", "2001:503:a83e:0:0:0:2:30"},
+	})
+	assert.Equal(t, tldInfo.DSRecords, []DSRecord{{
+		KeyTag:     30909,
+		Algorithm:  8,
+		DigestType: 2,
+		Digest:     "E2D3C916F6DEEAC73294E8268FB5885044A833FC5459588F4A9184CFC41A5766",
+	}})
+	assert.Equal(t, tldInfo.WhoisServer, "whois.verisign-grs.com")
+	assert.Equal(t, tldInfo.Status, "ACTIVE")
+	assert.Equal(t, tldInfo.Remarks, []string{"Registration information: http://www.verisigninc.com"})
+	assert.Equal(t, tldInfo.CreatedDate, "1985-01-01")
+	assert.NotNil(t, tldInfo.CreatedDateInTime)
+	assert.Equal(t, tldInfo.UpdatedDate, "2017-10-05")
+	assert.NotNil(t, tldInfo.UpdatedDateInTime)
+	assert.Equal(t, tldInfo.Source, "IANA")
+
+	for _, v := range []string{"cn_cn", "google_google", "swiss_swiss"} {
+		whoisRaw, err := xfile.ReadText(noterrorDir + "/" + v)
+		assert.Nil(t, err)
+		tldInfo, err := ParseTLD(whoisRaw)
+		assert.Nil(t, err, v)
+		assert.Equal(t, tldInfo.TLD, v[:len(v)/2], v)
+		assert.NotNil(t, tldInfo.Organisation, v)
+		assert.NotNil(t, tldInfo.Administrative, v)
+		assert.NotNil(t, tldInfo.Technical, v)
+		assert.NotZero(t, tldInfo.NameServers, v)
+		assert.NotZero(t, tldInfo.WhoisServer, v)
+	}
+
+	tldInfo, err = ParseTLD(`refer:        whois.verisign-grs.com
+
+domain:       COM`)
+	assert.Nil(t, err)
+	assert.Equal(t, tldInfo.Refer, "whois.verisign-grs.com")
+
+	_, err = ParseTLD(`% IANA WHOIS server
+% This query returned 0 objects.`)
+	assert.Equal(t, err, ErrNotFoundDomain)
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sectionRule is a section of whois data which must be closed by one of the end markers
+type sectionRule struct {
+	begin string
+	end   []string
+}
+
+var (
+	// icannBeginMarker is the marker of icann format whois data
+	icannBeginMarker = "registry domain id:"
+	// icannEndMarker is the terminal footer of icann format whois data
+	icannEndMarker = ">>> last update of"
+
+	// sectionRules is the sections of whois data by extension
+	sectionRules = map[string][]sectionRule{
+		"jp": {
+			{"Domain Information:", []string{"[Last Update", "[最終更新]"}},
+			{"Contact Information:", []string{"[Fax]", "[FAX番号]"}},
+		},
+		"tw": {
+			{"Registrant:", []string{"Domain servers in listed order:"}},
+			{"註冊人:", []string{"域名伺服器:"}},
+		},
+		"hk": {
+			{"Registrant Contact Information:", []string{"Status Information:"}},
+			{"註冊人聯絡資料：", []string{"狀態資料："}},
+		},
+	}
+)
+
+// searchTruncated returns the reason if whois data looks truncated, it is
+// truncated if the icann footer is missing or a section is not closed,
+// the missing fields of a sparse record are reported by Quality instead
+func searchTruncated(text, extension string) string {
+	if containsFold(text, icannBeginMarker) && !containsFold(text, icannEndMarker) {
+		return "icann footer is missing"
+	}
+
+	for _, v := range sectionRules[extension] {
+		begin := strings.Index(text, v.begin)
+		if begin != -1 && !containsAny(text[begin:], v.end) {
+			return fmt.Sprintf("section %q is not closed", v.begin)
+		}
+	}
+
+	return ""
+}
+
+// containsAny returns if text contains any of substrs
+func containsAny(text string, substrs []string) bool {
+	for _, v := range substrs {
+		if strings.Contains(text, v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2014-2026 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/likexian/gokit/assert"
+	"github.com/likexian/gokit/xfile"
+)
+
+func TestParseTruncated(t *testing.T) {
+	tests := []struct {
+		name   string
+		lines  int
+		reason string
+	}{
+		{"com_google.com", 30, "icann footer is missing"},
+		{"jp_google.jp", 26, `section "Contact Information:" is not closed`},
+		{"tw_google.tw", 20, `section "Registrant:" is not closed`},
+		{"hk_google.hk", 60, `section "Registrant Contact Information:" is not closed`},
+	}
+
+	for _, v := range tests {
+		data, err := xfile.ReadText(noterrorDir + "/" + v.name)
+		assert.Nil(t, err)
+
+		whoisInfo, err := Parse(data)
+		assert.Nil(t, err, v.name)
+
+		lines := strings.Split(data, "\n")
+		whoisInfo, err = Parse(strings.Join(lines[:v.lines], "\n"))
+		assert.True(t, errors.Is(err, ErrDomainDataTruncated), v.name)
+		assert.Contains(t, err.Error(), v.reason, v.name)
+		assert.NotNil(t, whoisInfo.Domain, v.name)
+		assert.Equal(t, whoisInfo.Domain.Domain, strings.Split(v.name, "_")[1], v.name)
+	}
+
+	inlines := []struct {
+		data   string
+		domain string
+		reason string
+	}{
+		{"域名: example.tw\n   註冊人:\n      Example Org\n", "example.tw", `section "註冊人:" is not closed`},
+		{"域名： example.hk\n\n註冊人聯絡資料：\n公司英文名稱： Example Org\n", "example.hk",
+			`section "註冊人聯絡資料：" is not closed`},
+	}
+
+	for _, v := range inlines {
+		whoisInfo, err := Parse(v.data)
+		assert.True(t, errors.Is(err, ErrDomainDataTruncated), v.domain)
+		assert.Contains(t, err.Error(), v.reason, v.domain)
+		assert.Equal(t, whoisInfo.Domain.Domain, v.domain)
+	}
+}
+
+func TestParseSparse(t *testing.T) {
+	data := "Domain Name: example.za.net\nCreation Date: 2020-01-01\nName Server: ns1.example.com\nStatus: ok\n"
+	whoisInfo, err := Parse(data)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "example.za.net")
+
+	quality := whoisInfo.Quality()
+	assert.Contains(t, quality.Missing, FieldDomainExpirationDate)
+	assert.Contains(t, quality.Missing, FieldRegistrarName)
+
+	data, err = xfile.ReadText(noterrorDir + "/de_google.de")
+	assert.Nil(t, err)
+
+	lines := strings.Split(data, "\n")
+	_, err = Parse(strings.Join(lines[:3], "\n"))
+	assert.Nil(t, err)
+}
@@ -20,7 +20,6 @@
 package whoisparser
 
 import (
-	"fmt"
 	"sort"
 	"strings"
 	"time"
@@ -36,18 +35,13 @@ func isDNSSecEnabled(data string) bool {
 	}
 }
 
-// clearKeyName returns cleared key name
-func clearKeyName(key string) string {
-	if strings.Contains(key, "(") {
-		key = strings.Split(key, "(")[0]
-	}
+// keyNameReplacer replaces the separators of key name with space
+var keyNameReplacer = strings.NewReplacer("-", " ", "_", " ", "/", " ", "\\", " ", "'", " ", ".", " ")
 
-	key = strings.ReplaceAll(key, "-", " ")
-	key = strings.ReplaceAll(key, "_", " ")
-	key = strings.ReplaceAll(key, "/", " ")
-	key = strings.ReplaceAll(key, "\\", " ")
-	key = strings.ReplaceAll(key, "'", " ")
-	key = strings.ReplaceAll(key, ".", " ")
+// clearKeyName returns cleared key name, localized key name is returned in english
+func clearKeyName(key string) string {
+	key, _, _ = strings.Cut(key, "(")
+	key = keyNameReplacer.Replace(key)
 
 	key = strings.TrimPrefix(key, "Registry ")
 	key = strings.TrimPrefix(key, "Sponsoring ")
@@ -55,6 +49,10 @@ func clearKeyName(key string) string {
 	key = strings.TrimSpace(key)
 	key = strings.ToLower(key)
 
+	if v, ok := keyNameRule[key]; ok {
+		return v
+	}
+
 	return key
 }
 
@@ -102,6 +100,29 @@ func containsIn(data string, substrs []string) bool {
 	return false
 }
 
+// searchIn returns the trimmed line of data containing any of substrs,
+// substrs must be lowercase and are matched case-insensitively
+func searchIn(data string, substrs []string) string {
+	for _, line := range strings.Split(data, "\n") {
+		if containsIn(strings.ToLower(line), substrs) {
+			return strings.TrimSpace(line)
+		}
+	}
+
+	return ""
+}
+
+// containsFold returns if text contains substr case-insensitively, substr must be lowercase
+func containsFold(text, substr string) bool {
+	for i := 0; i+len(substr) <= len(text); i++ {
+		if strings.EqualFold(text[i:i+len(substr)], substr) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Keys returns all keys of map by sort
 func keys(m map[string]string) []string {
 	r := []string{}
@@ -115,65 +136,57 @@ func keys(m map[string]string) []string {
 	return r
 }
 
-// parseDateString attempts to parse a given date using a collection of common
-// format strings. Date formats containing time components are tried first
-// before attempts are made using date-only formats.
+// parseDateString attempts to parse a given date using the default date parser
 func parseDateString(datetime string) (time.Time, error) {
-	datetime = strings.Trim(datetime, ".")
-	datetime = strings.ReplaceAll(datetime, ". ", "-")
-
-	formats := [...]string{
-		// Date & time formats
-		"2006-01-02 15:04:05",
-		"2006.01.02 15:04:05",
-		"02/01/2006 15:04:05",
-		"02.01.2006 15:04:05",
-		"02.1.2006 15:04:05",
-		"2.1.2006 15:04:05",
-		"02-Jan-2006 15:04:05",
-		"20060102 15:04:05",
-		time.ANSIC,
-		time.Stamp,
-		time.StampMilli,
-		time.StampMicro,
-		time.StampNano,
-
-		// Date, time & time zone formats
-		"2006-01-02T15:04:05Z",
-		"2006-01-02 15:04:05-07",
-		"2006-01-02 15:04:05 MST",
-		"2006-01-02 15:04:05 (MST+3)",
-		time.UnixDate,
-		time.RubyDate,
-		time.RFC822,
-		time.RFC822Z,
-		time.RFC850,
-		time.RFC1123,
-		time.RFC1123Z,
-		time.RFC3339,
-		time.RFC3339Nano,
-
-		// Date only formats
-		"2006-01-02",
-		"02-Jan-2006",
-		"02.01.2006",
-		"02-01-2006",
-		"January _2 2006",
-		"Mon Jan _2 2006",
-		"02/01/2006",
-		"01/02/2006",
-		"2006/01/02",
-		"2006-Jan-02",
-		"before Jan-2006",
+	result, _, err := defaultDateParser.Parse(datetime)
+	return result, err
+}
+
+// lineScanner scans text line by line without splitting it
+type lineScanner struct {
+	text string
+	pos  int
+}
+
+// next returns the next line, ok is false if there is no more line
+func (s *lineScanner) next() (line string, ok bool) {
+	if s.pos > len(s.text) {
+		return "", false
+	}
+
+	end := strings.IndexByte(s.text[s.pos:], '\n')
+	if end == -1 {
+		line = s.text[s.pos:]
+		s.pos = len(s.text) + 1
+		return line, true
 	}
 
-	for _, format := range formats {
-		result, err := time.Parse(format, datetime)
-		if err != nil {
-			continue
+	line = s.text[s.pos : s.pos+end]
+	s.pos += end + 1
+
+	return line, true
+}
+
+// joinValues joins the following lines without colon to line by comma,
+// the scanner stops before the next line with colon
+func (s *lineScanner) joinValues(line string) string {
+	var result strings.Builder
+	result.WriteString(line)
+
+	for {
+		pos := s.pos
+		next, ok := s.next()
+		if !ok {
+			break
+		}
+		next = strings.TrimSpace(next)
+		if strings.IndexByte(next, ':') != -1 {
+			s.pos = pos
+			break
 		}
-		return result, nil
+		result.WriteString(next)
+		result.WriteByte(',')
 	}
 
-	return time.Now(), fmt.Errorf("could not parse %s as a date", datetime)
+	return strings.Trim(result.String(), ",")
 }